@@ -0,0 +1,69 @@
+package fmt
+
+import "testing"
+
+// Budget pins the per-call allocation count for hot paths so a future
+// change that regresses them fails CI instead of a quarterly benchmark run.
+// Update it consciously -- alongside a note of what justified the change.
+//
+// Sprintf/string and Sprintf/int dropped from 8 to 2 now that Sprintf
+// actually dispatches to sprintfFast's pooled scratch buffer for these
+// formats -- the remaining allocations are the variadic args slice and the
+// final string(buf) copy out of the pooled buffer.
+var Budget = map[string]float64{
+	"Sprintf/string":  2,
+	"Sprintf/int":     2,
+	"Convert/int":     4,
+	"Convert/ToLower": 4,
+}
+
+func TestAllocBudget(t *testing.T) {
+	cases := []struct {
+		name string
+		fn   func()
+	}{
+		{"Sprintf/string", func() { _ = Sprintf("Hello %s", "World") }},
+		{"Sprintf/int", func() { _ = Sprintf("Number: %d", 42) }},
+		{"Convert/int", func() { _ = Convert(42).String() }},
+		{"Convert/ToLower", func() { _ = Convert("HELLO").ToLower().String() }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(1000, tc.fn)
+			budget := Budget[tc.name]
+			if allocs > budget {
+				t.Errorf("%s: allocs/run = %.1f, budget = %.1f", tc.name, allocs, budget)
+			}
+		})
+	}
+}
+
+func TestSprintfFastPath(t *testing.T) {
+	tests := []struct {
+		format string
+		args   []any
+		want   string
+	}{
+		{"Hello %s", []any{"World"}, "Hello World"},
+		{"Number: %d", []any{42}, "Number: 42"},
+		{"100%%", nil, "100%"},
+	}
+
+	for _, tt := range tests {
+		got, ok := sprintfFast(tt.format, tt.args...)
+		if !ok {
+			t.Errorf("sprintfFast(%q) fell back to general path unexpectedly", tt.format)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("sprintfFast(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestSprintfFastPathFallback(t *testing.T) {
+	if _, ok := sprintfFast("%x", 255); ok {
+		t.Error("expected fallback for unsupported verb %x")
+	}
+}
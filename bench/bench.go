@@ -0,0 +1,217 @@
+// Package bench is an in-process benchmark harness modeled on testing.B's
+// calibration and timer semantics, for callers that want a *testing.B-shaped
+// Result (Iterations, NsPerOp, BytesPerOp, AllocsPerOp) without going
+// through "go test -bench" and parsing its text output -- e.g. benchmark/
+// populating a MemoryComparison directly instead of shelling out to `go
+// test` and regexing the result line.
+package bench
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultTargetDuration is the calibration target Run uses when no spec is
+// given, matching `go test -bench`'s own default benchtime.
+const defaultTargetDuration = time.Second
+
+// maxIterations caps calibrated iteration counts, mirroring the 1e9 ceiling
+// testing/benchmark.go applies.
+const maxIterations = 1e9
+
+// B drives one benchmark iteration, the subset of testing.B a benchmark
+// closure needs: N, ReportAllocs, and Start/Stop/ResetTimer for excluding
+// setup work from the timed region.
+type B struct {
+	N int
+
+	reportAllocs bool
+	timerOn      bool
+	start        time.Time
+	elapsed      time.Duration
+
+	timerStartAllocs uint64
+	timerStartBytes  uint64
+	netAllocs        uint64
+	netBytes         uint64
+}
+
+// ReportAllocs marks this run as wanting per-op allocation counts. It
+// exists for parity with testing.B.ReportAllocs; Run always measures
+// allocations, so calling it is optional.
+func (b *B) ReportAllocs() { b.reportAllocs = true }
+
+// StartTimer starts (or resumes) the timed region.
+func (b *B) StartTimer() {
+	if b.timerOn {
+		return
+	}
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	b.timerStartAllocs = m.Mallocs
+	b.timerStartBytes = m.TotalAlloc
+	b.start = time.Now()
+	b.timerOn = true
+}
+
+// StopTimer pauses the timed region, folding the elapsed time and net
+// allocations since the matching StartTimer into the run's totals -- so a
+// benchmark can exclude expensive setup/teardown from what gets reported.
+func (b *B) StopTimer() {
+	if !b.timerOn {
+		return
+	}
+	b.elapsed += time.Since(b.start)
+
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	b.netAllocs += m.Mallocs - b.timerStartAllocs
+	b.netBytes += m.TotalAlloc - b.timerStartBytes
+	b.timerOn = false
+}
+
+// ResetTimer zeroes the elapsed time and allocation counters accumulated so
+// far, preserving whether the timer is currently running.
+func (b *B) ResetTimer() {
+	running := b.timerOn
+	if running {
+		b.StopTimer()
+	}
+	b.elapsed = 0
+	b.netAllocs = 0
+	b.netBytes = 0
+	if running {
+		b.StartTimer()
+	}
+}
+
+// Result is one calibrated benchmark run's measurements.
+type Result struct {
+	Name        string
+	Iterations  int64
+	NsPerOp     int64
+	BytesPerOp  int64
+	AllocsPerOp int64
+}
+
+// measurement is one timed+measured call to fn at a given iteration count.
+type measurement struct {
+	elapsed time.Duration
+	allocs  uint64
+	bytes   uint64
+}
+
+func measure(fn func(b *B), n int64) measurement {
+	b := &B{N: int(n)}
+	b.StartTimer()
+	fn(b)
+	b.StopTimer()
+	return measurement{elapsed: b.elapsed, allocs: b.netAllocs, bytes: b.netBytes}
+}
+
+// overhead measures the fixed cost of StartTimer/StopTimer's own
+// runtime.ReadMemStats calls against an empty warm-up run, so Run can
+// subtract it from the timed run rather than attribute it to fn.
+func overhead() measurement {
+	return measure(func(b *B) {}, 1)
+}
+
+// nextN scales n the way testing/benchmark.go's calibration loop does:
+// grow toward the iteration count the previous run's rate suggests would
+// hit target, but by at least one and at most 100x per step.
+func nextN(n int64, elapsed, target time.Duration) int64 {
+	prevNs := elapsed.Nanoseconds()
+	if prevNs <= 0 {
+		prevNs = 1
+	}
+	scaled := n * target.Nanoseconds() / prevNs
+
+	next := n + 1
+	if scaled > next {
+		next = scaled
+	}
+	if step := n * 100; next > step {
+		next = step
+	}
+	if next > maxIterations {
+		next = maxIterations
+	}
+	return next
+}
+
+func toResult(name string, m, base measurement, n int64) Result {
+	if n <= 0 {
+		n = 1
+	}
+	elapsed := m.elapsed - base.elapsed
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	allocs := int64(m.allocs) - int64(base.allocs)
+	if allocs < 0 {
+		allocs = 0
+	}
+	bytes := int64(m.bytes) - int64(base.bytes)
+	if bytes < 0 {
+		bytes = 0
+	}
+	return Result{
+		Name:        name,
+		Iterations:  n,
+		NsPerOp:     elapsed.Nanoseconds() / n,
+		AllocsPerOp: allocs / n,
+		BytesPerOp:  bytes / n,
+	}
+}
+
+// parseFixedCount reports the iteration count spec specifies in "10000x"
+// form, and whether spec was in that form at all.
+func parseFixedCount(spec string) (int64, bool) {
+	if !strings.HasSuffix(spec, "x") {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(strings.TrimSuffix(spec, "x"), 10, 64)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return n, true
+}
+
+// Run calibrates fn's iteration count using a 1s target duration (`go
+// test -bench`'s own default benchtime) and returns its Result.
+func Run(name string, fn func(b *B)) Result {
+	return RunSpec(name, "", fn)
+}
+
+// RunSpec runs fn under a calibration spec: a fixed count ("10000x"), a
+// target duration ("3s"), or "" for the 1s default. A fixed count skips
+// calibration and runs fn exactly that many times; a duration calibrates
+// starting at n=1 and growing n toward the target the way
+// testing/benchmark.go does (see nextN).
+func RunSpec(name, spec string, fn func(b *B)) Result {
+	base := overhead()
+
+	if n, ok := parseFixedCount(spec); ok {
+		return toResult(name, measure(fn, n), base, n)
+	}
+
+	target := defaultTargetDuration
+	if spec != "" {
+		if d, err := time.ParseDuration(spec); err == nil {
+			target = d
+		}
+	}
+
+	n := int64(1)
+	var last measurement
+	for {
+		last = measure(fn, n)
+		if last.elapsed >= target || n >= maxIterations {
+			break
+		}
+		n = nextN(n, last.elapsed, target)
+	}
+	return toResult(name, last, base, n)
+}
@@ -0,0 +1,105 @@
+package bench
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunSpecFixedCount(t *testing.T) {
+	var calls int
+	result := RunSpec("fixed", "25x", func(b *B) {
+		for i := 0; i < b.N; i++ {
+			calls++
+		}
+	})
+
+	if result.Iterations != 25 {
+		t.Errorf("Iterations = %d, want 25", result.Iterations)
+	}
+	if calls != 25 {
+		t.Errorf("fn ran for %d total iterations across calls, want 25", calls)
+	}
+}
+
+func TestRunSpecDuration(t *testing.T) {
+	result := RunSpec("short", "20ms", func(b *B) {
+		for i := 0; i < b.N; i++ {
+			_ = strings.Repeat("x", 4)
+		}
+	})
+
+	if result.Iterations <= 0 {
+		t.Fatalf("Iterations = %d, want > 0", result.Iterations)
+	}
+	if result.NsPerOp < 0 {
+		t.Errorf("NsPerOp = %d, want >= 0", result.NsPerOp)
+	}
+}
+
+func TestRunSpecDefaultsToOneSecond(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping 1s calibration in short mode")
+	}
+	start := time.Now()
+	RunSpec("default", "", func(b *B) {
+		for i := 0; i < b.N; i++ {
+		}
+	})
+	if elapsed := time.Since(start); elapsed < defaultTargetDuration {
+		t.Errorf("calibration finished in %v, want at least %v", elapsed, defaultTargetDuration)
+	}
+}
+
+func TestStartStopTimerExcludesSetup(t *testing.T) {
+	result := RunSpec("setup", "5x", func(b *B) {
+		b.StopTimer()
+		time.Sleep(10 * time.Millisecond)
+		b.StartTimer()
+		for i := 0; i < b.N; i++ {
+		}
+	})
+
+	if result.NsPerOp >= int64(10*time.Millisecond) {
+		t.Errorf("NsPerOp = %d, expected setup sleep to be excluded from timing", result.NsPerOp)
+	}
+}
+
+// allocSink keeps TestMeasuresAllocations' slices escaping to the heap; a
+// discarded make([]byte, 64) is stack-allocated and never counted.
+var allocSink []byte
+
+func TestMeasuresAllocations(t *testing.T) {
+	result := RunSpec("allocs", "100x", func(b *B) {
+		for i := 0; i < b.N; i++ {
+			allocSink = make([]byte, 64)
+		}
+	})
+
+	if result.AllocsPerOp == 0 {
+		t.Errorf("AllocsPerOp = 0, want > 0 for a function that allocates every iteration")
+	}
+	if result.BytesPerOp == 0 {
+		t.Errorf("BytesPerOp = 0, want > 0 for a function that allocates every iteration")
+	}
+}
+
+func TestParseFixedCount(t *testing.T) {
+	tests := []struct {
+		spec   string
+		want   int64
+		wantOK bool
+	}{
+		{"10000x", 10000, true},
+		{"3s", 0, false},
+		{"", 0, false},
+		{"0x", 0, false},
+		{"-5x", 0, false},
+	}
+	for _, tt := range tests {
+		n, ok := parseFixedCount(tt.spec)
+		if n != tt.want || ok != tt.wantOK {
+			t.Errorf("parseFixedCount(%q) = (%d, %v), want (%d, %v)", tt.spec, n, ok, tt.want, tt.wantOK)
+		}
+	}
+}
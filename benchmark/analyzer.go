@@ -1,11 +1,7 @@
 package main
 
 import (
-	"bufio"
 	"os"
-	"os/exec"
-	"path/filepath"
-	"regexp"
 	"strings"
 
 	. "github.com/tinywasm/fmt"
@@ -31,32 +27,46 @@ type MemoryComparison struct {
 
 func main() {
 	if len(os.Args) < 2 {
-		println(Sprintf("Usage: go run analyzer.go [binary|memory|all]"))
+		println(Sprintf("Usage: go run analyzer.go [binary|memory|all] [-format=md|csv|json] [-out=file]"))
 		println(Sprintf("  binary  - Analyze binary sizes"))
 		println(Sprintf("  memory  - Analyze memory allocations"))
 		println(Sprintf("  all     - Run both analyses"))
+		println(Sprintf("  -format - Output format, default md (ASCII table + README update)"))
+		println(Sprintf("  -out    - Write csv/json output to file instead of stdout"))
 		return
 	}
 
 	mode := os.Args[1]
+	format := FormatMD
+	outPath := ""
+	for _, arg := range os.Args[2:] {
+		switch {
+		case strings.HasPrefix(arg, "-format="):
+			format = parseOutputFormat(strings.TrimPrefix(arg, "-format="))
+		case strings.HasPrefix(arg, "-out="):
+			outPath = strings.TrimPrefix(arg, "-out=")
+		}
+	}
 
 	switch mode {
 	case "binary":
-		analyzeBinarySizes()
+		analyzeBinarySizes(format, outPath)
 	case "memory":
-		analyzeMemoryAllocations()
+		analyzeMemoryAllocations(format, outPath)
 	case "all":
-		analyzeBinarySizes()
+		analyzeBinarySizes(format, outPath)
 		println()
-		analyzeMemoryAllocations()
+		analyzeMemoryAllocations(format, outPath)
 	default:
 		LogError(Sprintf("Unknown mode: %s", mode))
 		return
 	}
 }
 
-// analyzeBinarySizes analyzes and reports binary size comparisons
-func analyzeBinarySizes() {
+// analyzeBinarySizes analyzes and reports binary size comparisons. For
+// FormatCSV/FormatJSON it writes machine-readable records (to outPath, or
+// stdout when outPath is empty) instead of the ASCII table + README update.
+func analyzeBinarySizes(format OutputFormat, outPath string) {
 	LogStep("Analyzing binary sizes with multiple optimization levels...")
 
 	binaries := measureBinarySizes()
@@ -65,6 +75,15 @@ func analyzeBinarySizes() {
 		return
 	}
 
+	if format == FormatCSV || format == FormatJSON {
+		if err := writeRecords(format, outPath, binaryInfoRecords(binaries)); err != nil {
+			LogError(Sprintf("Failed to write %s output: %v", format, err))
+			return
+		}
+		LogSuccess("Binary size analysis completed")
+		return
+	}
+
 	displayBinaryResults(binaries)
 	displayOptimizationTable(binaries)
 	updateREADMEWithBinaryData(binaries)
@@ -72,16 +91,13 @@ func analyzeBinarySizes() {
 	LogSuccess("Binary size analysis completed and README updated")
 }
 
-// analyzeMemoryAllocations analyzes and reports memory allocation comparisons
-func analyzeMemoryAllocations() {
+// analyzeMemoryAllocations analyzes and reports memory allocation
+// comparisons. For FormatCSV/FormatJSON it writes machine-readable records
+// (to outPath, or stdout when outPath is empty) instead of the ASCII table
+// + README update.
+func analyzeMemoryAllocations(format OutputFormat, outPath string) {
 	LogStep("Starting memory allocation benchmark...")
 
-	// Check if we can run benchmarks
-	if !checkGoBenchAvailable() {
-		LogError("Cannot run Go benchmarks")
-		return
-	}
-
 	// Run memory benchmarks
 	comparisons := runMemoryBenchmarks()
 	if len(comparisons) == 0 {
@@ -89,6 +105,15 @@ func analyzeMemoryAllocations() {
 		return
 	}
 
+	if format == FormatCSV || format == FormatJSON {
+		if err := writeRecords(format, outPath, memoryComparisonRecords(comparisons)); err != nil {
+			LogError(Sprintf("Failed to write %s output: %v", format, err))
+			return
+		}
+		LogSuccess("Memory benchmark completed")
+		return
+	}
+
 	// Display results
 	displayMemoryResults(comparisons)
 
@@ -231,127 +256,14 @@ func getOptimizationConfigs() []OptimizationConfig {
 	}
 }
 
-// checkGoBenchAvailable checks if Go benchmarks can be run
-func checkGoBenchAvailable() bool {
-	_, err := exec.LookPath("go")
-	return err == nil
-}
-
-// runMemoryBenchmarks executes memory benchmarks and returns comparisons
+// runMemoryBenchmarks executes memory benchmarks and returns comparisons.
+// It runs every registered inProcessBenchmarks pair in-process via
+// bench.Run, replacing the former shell-scripts + parser round trip
+// through `go test -bench` against a bench-memory-alloc/{standard,
+// tinystring} directory pair.
 func runMemoryBenchmarks() []MemoryComparison {
-	var comparisons []MemoryComparison
-
-	// Run standard library benchmarks
-	LogInfo("Running standard library memory benchmarks...")
-	standardResults := runBenchmarks("standard")
-
-	// Run fmt benchmarks
-	LogInfo("Running fmt memory benchmarks...")
-	tinystringResults := runBenchmarks("tinystring")
-
-	// Create comparisons
-	comparisons = append(comparisons, createComparison(
-		"String Processing",
-		findBenchmark(standardResults, "BenchmarkStringProcessing"),
-		findBenchmark(tinystringResults, "BenchmarkStringProcessing"),
-	))
-
-	comparisons = append(comparisons, createComparison(
-		"Number Processing",
-		findBenchmark(standardResults, "BenchmarkNumberProcessing"),
-		findBenchmark(tinystringResults, "BenchmarkNumberProcessing"),
-	))
-
-	comparisons = append(comparisons, createComparison(
-		"Mixed Operations",
-		findBenchmark(standardResults, "BenchmarkMixedOperations"),
-		findBenchmark(tinystringResults, "BenchmarkMixedOperations"),
-	))
-
-	// Check for pointer optimization benchmark (fmt only)
-	pointerBench := findBenchmark(tinystringResults, "BenchmarkStringProcessingWithPointers")
-	if pointerBench.Name != "" {
-		standardEquivalent := findBenchmark(standardResults, "BenchmarkStringProcessing")
-		comparisons = append(comparisons, createComparison(
-			"String Processing (Pointer Optimization)",
-			standardEquivalent,
-			pointerBench,
-		))
-	}
-
-	return comparisons
-}
-
-// runBenchmarks executes benchmarks for a specific library implementation
-func runBenchmarks(library string) []BenchmarkResult {
-	var results []BenchmarkResult
-
-	benchDir := filepath.Join("bench-memory-alloc", library)
-	if !FileExists(benchDir) {
-		LogError(Sprintf("Benchmark directory %s not found", benchDir))
-		return results
-	}
-	cmd := exec.Command("go", "test", "-bench=.", "-benchmem", "-run=^$")
-	cmd.Dir = benchDir
-
-	output, err := cmd.Output()
-	if err != nil {
-		LogError(Sprintf("Failed to run benchmarks in %s: %v", benchDir, err))
-		return results
-	}
-
-	return parseBenchmarkOutput(string(output), library)
-}
-
-// parseBenchmarkOutput parses Go benchmark output into structured results
-func parseBenchmarkOutput(output, library string) []BenchmarkResult {
-	var results []BenchmarkResult
-
-	scanner := bufio.NewScanner(strings.NewReader(output))
-	benchmarkRegex := regexp.MustCompile(`^(Benchmark\w+)(?:-\d+)?\s+(\d+)\s+(\d+)\s+ns/op\s+(\d+)\s+B/op\s+(\d+)\s+allocs/op`)
-	for scanner.Scan() {
-		line := scanner.Text()
-		matches := benchmarkRegex.FindStringSubmatch(line)
-
-		if len(matches) == 6 {
-			iterations, _ := Convert(matches[2]).Int64()
-			nsPerOp, _ := Convert(matches[3]).Int64()
-			bytesPerOp, _ := Convert(matches[4]).Int64()
-			allocsPerOp, _ := Convert(matches[5]).Int64()
-
-			out := BenchmarkResult{
-				Name:        matches[1],
-				Library:     library,
-				Iterations:  iterations,
-				NsPerOp:     nsPerOp,
-				BytesPerOp:  bytesPerOp,
-				AllocsPerOp: allocsPerOp,
-			}
-
-			results = append(results, out)
-		}
-	}
-
-	return results
-}
-
-// createComparison creates a memory comparison between two benchmark results
-func createComparison(category string, standard, tinystring BenchmarkResult) MemoryComparison {
-	return MemoryComparison{
-		Standard: standard,
-		fmt:      tinystring,
-		Category: category,
-	}
-}
-
-// findBenchmark finds a benchmark out by name
-func findBenchmark(results []BenchmarkResult, name string) BenchmarkResult {
-	for _, out := range results {
-		if out.Name == name {
-			return out
-		}
-	}
-	return BenchmarkResult{}
+	LogInfo("Running in-process memory benchmarks...")
+	return runInProcessMemoryBenchmarks()
 }
 
 // displayMemoryResults shows memory benchmark results in a table format
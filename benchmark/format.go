@@ -0,0 +1,185 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+
+	. "github.com/tinywasm/fmt"
+)
+
+// OutputFormat selects how analyzer results are rendered: "md" (the
+// default ASCII table + README update), "csv" or "json" for CI pipelines
+// that want machine-readable results instead.
+type OutputFormat string
+
+const (
+	FormatMD   OutputFormat = "md"
+	FormatCSV  OutputFormat = "csv"
+	FormatJSON OutputFormat = "json"
+)
+
+// parseOutputFormat maps a -format= flag value to an OutputFormat,
+// defaulting to FormatMD for anything unrecognized.
+func parseOutputFormat(s string) OutputFormat {
+	switch OutputFormat(s) {
+	case FormatCSV:
+		return FormatCSV
+	case FormatJSON:
+		return FormatJSON
+	default:
+		return FormatMD
+	}
+}
+
+// outputRecord is the flat, stable-column record shared by csv and json
+// output: one row per BinaryInfo or per side of a MemoryComparison.
+type outputRecord struct {
+	Category       string  `json:"category"`
+	Library        string  `json:"library"`
+	Type           string  `json:"type"`
+	OptLevel       string  `json:"opt_level"`
+	Bytes          int64   `json:"bytes"`
+	Allocs         int64   `json:"allocs"`
+	NsPerOp        int64   `json:"ns_per_op"`
+	ImprovementPct float64 `json:"improvement_pct"`
+}
+
+var outputRecordColumns = []string{
+	"category", "library", "type", "opt_level", "bytes", "allocs", "ns_per_op", "improvement_pct",
+}
+
+// binaryInfoRecords converts measured binaries into outputRecords, pairing
+// each "tinystring" binary with its "standard" counterpart at the same
+// type and optimization level to compute improvement_pct.
+func binaryInfoRecords(binaries []BinaryInfo) []outputRecord {
+	records := make([]outputRecord, 0, len(binaries))
+	for _, b := range binaries {
+		var pct float64
+		if b.Library == "tinystring" {
+			suffix := ""
+			for _, opt := range getOptimizationConfigs() {
+				if opt.Suffix != "" && Contains(b.Name, opt.Suffix) {
+					suffix = opt.Suffix
+					break
+				}
+			}
+			standard := findBinaryByPattern(binaries, "standard", b.Type, suffix)
+			if standard.Name != "" {
+				pct = calculateImprovementPct(standard.Size, b.Size)
+			}
+		}
+
+		records = append(records, outputRecord{
+			Category:       "binary_size",
+			Library:        b.Library,
+			Type:           b.Type,
+			OptLevel:       b.OptLevel,
+			Bytes:          b.Size,
+			ImprovementPct: pct,
+		})
+	}
+	return records
+}
+
+// memoryComparisonRecords converts memory benchmark comparisons into
+// outputRecords, one row per library per comparison.
+func memoryComparisonRecords(comparisons []MemoryComparison) []outputRecord {
+	records := make([]outputRecord, 0, len(comparisons)*2)
+	for _, comp := range comparisons {
+		if comp.Standard.Name != "" {
+			records = append(records, outputRecord{
+				Category: comp.Category,
+				Library:  "standard",
+				Bytes:    comp.Standard.BytesPerOp,
+				Allocs:   comp.Standard.AllocsPerOp,
+				NsPerOp:  comp.Standard.NsPerOp,
+			})
+		}
+		if comp.fmt.Name != "" {
+			var pct float64
+			if comp.Standard.Name != "" {
+				pct = calculateImprovementPct(comp.Standard.BytesPerOp, comp.fmt.BytesPerOp)
+			}
+			records = append(records, outputRecord{
+				Category:       comp.Category,
+				Library:        "tinystring",
+				Bytes:          comp.fmt.BytesPerOp,
+				Allocs:         comp.fmt.AllocsPerOp,
+				NsPerOp:        comp.fmt.NsPerOp,
+				ImprovementPct: pct,
+			})
+		}
+	}
+	return records
+}
+
+// calculateImprovementPct returns the percentage by which improved is
+// smaller than original (negative when improved is larger), or 0 when
+// original is zero.
+func calculateImprovementPct(original, improved int64) float64 {
+	if original == 0 {
+		return 0
+	}
+	return float64(original-improved) / float64(original) * 100
+}
+
+// writeRecords renders records as CSV (RFC 4180, via encoding/csv) or as a
+// JSON array, to outPath, or to stdout when outPath is empty.
+func writeRecords(format OutputFormat, outPath string, records []outputRecord) error {
+	w := io.Writer(os.Stdout)
+	if outPath != "" {
+		f, err := os.Create(outPath)
+		if err != nil {
+			return Err(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	switch format {
+	case FormatCSV:
+		return writeRecordsCSV(w, records)
+	case FormatJSON:
+		return writeRecordsJSON(w, records)
+	default:
+		return Errf("unsupported record format: %s", format)
+	}
+}
+
+func writeRecordsCSV(w io.Writer, records []outputRecord) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(outputRecordColumns); err != nil {
+		return Err(err)
+	}
+	for _, r := range records {
+		row := []string{
+			r.Category,
+			r.Library,
+			r.Type,
+			r.OptLevel,
+			Convert(r.Bytes).String(),
+			Convert(r.Allocs).String(),
+			Convert(r.NsPerOp).String(),
+			Sprintf("%.2f", r.ImprovementPct),
+		}
+		if err := cw.Write(row); err != nil {
+			return Err(err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return Err(err)
+	}
+	return nil
+}
+
+func writeRecordsJSON(w io.Writer, records []outputRecord) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(records); err != nil {
+		return Err(err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/tinywasm/fmt/bench"
+
+	. "github.com/tinywasm/fmt"
+)
+
+// benchPair is one named standard-library-vs-fmt comparison point,
+// registered directly as closures instead of living in a
+// "bench-memory-alloc/{standard,tinystring}" directory that runBenchmarks
+// used to shell out to `go test -bench` against.
+type benchPair struct {
+	category string
+	standard func(b *bench.B)
+	tiny     func(b *bench.B)
+}
+
+var inProcessBenchmarks = []benchPair{
+	{
+		category: "String Processing",
+		standard: func(b *bench.B) {
+			for i := 0; i < b.N; i++ {
+				_ = strings.ToUpper(strings.TrimSpace("  hello world  "))
+			}
+		},
+		tiny: func(b *bench.B) {
+			for i := 0; i < b.N; i++ {
+				_ = Convert("  hello world  ").TrimSpace().ToUpper().String()
+			}
+		},
+	},
+	{
+		category: "Number Processing",
+		standard: func(b *bench.B) {
+			for i := 0; i < b.N; i++ {
+				_ = strconv.FormatInt(2189009, 10)
+			}
+		},
+		tiny: func(b *bench.B) {
+			for i := 0; i < b.N; i++ {
+				_ = Convert(2189009).Thousands().String()
+			}
+		},
+	},
+	{
+		category: "Mixed Operations",
+		standard: func(b *bench.B) {
+			for i := 0; i < b.N; i++ {
+				_ = strings.ToUpper(strings.TrimSpace(" ok ")) + strconv.FormatInt(2189009, 10)
+			}
+		},
+		tiny: func(b *bench.B) {
+			for i := 0; i < b.N; i++ {
+				_ = Convert(" ok ").TrimSpace().ToUpper().String() + Convert(2189009).Thousands().String()
+			}
+		},
+	},
+}
+
+// toBenchmarkResult adapts a bench.Result to the BenchmarkResult shape
+// displayMemoryResults/generateMemorySection already format.
+func toBenchmarkResult(r bench.Result, library string) BenchmarkResult {
+	return BenchmarkResult{
+		Name:        r.Name,
+		Library:     library,
+		Iterations:  r.Iterations,
+		NsPerOp:     r.NsPerOp,
+		BytesPerOp:  r.BytesPerOp,
+		AllocsPerOp: r.AllocsPerOp,
+	}
+}
+
+// runInProcessMemoryBenchmarks runs every registered benchPair through
+// bench.Run and builds one MemoryComparison per pair, in-process -- the
+// replacement for runMemoryBenchmarks' shell-scripts + parser round trip
+// through `go test -bench` and parseBenchmarkOutput.
+func runInProcessMemoryBenchmarks() []MemoryComparison {
+	comparisons := make([]MemoryComparison, 0, len(inProcessBenchmarks))
+	for _, pair := range inProcessBenchmarks {
+		standard := bench.Run(pair.category, pair.standard)
+		tiny := bench.Run(pair.category, pair.tiny)
+		comparisons = append(comparisons, MemoryComparison{
+			Standard: toBenchmarkResult(standard, "standard"),
+			fmt:      toBenchmarkResult(tiny, "tinystring"),
+			Category: pair.category,
+		})
+	}
+	return comparisons
+}
@@ -0,0 +1,53 @@
+package main
+
+// RegressionEntry flags one row whose improvement percentage dropped by
+// more than Diff's threshold between two reports of the same kind.
+type RegressionEntry struct {
+	Key      string
+	PrevPct  float64
+	CurrPct  float64
+	DeltaPct float64 // CurrPct - PrevPct; negative means it got worse
+}
+
+// RegressionSet is Diff's result: every row that regressed past the
+// threshold, in the order Diff encountered them in curr.
+type RegressionSet struct {
+	Entries []RegressionEntry
+}
+
+// HasRegressions reports whether any row regressed past Diff's threshold.
+func (s RegressionSet) HasRegressions() bool {
+	return len(s.Entries) > 0
+}
+
+// Diff compares prev and curr -- two reports of the same kind (both
+// BinaryReport or both MemoryReport) -- and flags every row present in
+// both whose improvement percentage dropped by more than thresholdPct, so
+// a CI job can fail the build on a size or memory regression without
+// re-parsing MarkdownRenderer's tables. Rows missing from either side are
+// ignored: Diff only compares like-for-like keys.
+func Diff(prev, curr Report, thresholdPct float64) RegressionSet {
+	prevByKey := make(map[string]float64, len(prev.ImprovementRows()))
+	for _, row := range prev.ImprovementRows() {
+		prevByKey[row.Key] = row.ImprovementPct
+	}
+
+	var entries []RegressionEntry
+	for _, row := range curr.ImprovementRows() {
+		prevPct, ok := prevByKey[row.Key]
+		if !ok {
+			continue
+		}
+		delta := row.ImprovementPct - prevPct
+		if delta < -thresholdPct {
+			entries = append(entries, RegressionEntry{
+				Key:      row.Key,
+				PrevPct:  prevPct,
+				CurrPct:  row.ImprovementPct,
+				DeltaPct: delta,
+			})
+		}
+	}
+
+	return RegressionSet{Entries: entries}
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+
+	. "github.com/tinywasm/fmt"
+)
+
+// ReportRenderer renders a BinaryReport/MemoryReport into its final form.
+// MarkdownRenderer reproduces the README section format ReportGenerator has
+// always produced; JSONRenderer and CSVRenderer give CI tooling (size-
+// regression bots, trend graphs) a machine-readable form of the same data
+// without having to re-parse the markdown tables.
+type ReportRenderer interface {
+	RenderBinary(BinaryReport) (string, error)
+	RenderMemory(MemoryReport) (string, error)
+}
+
+// MarkdownRenderer renders reports as the GitHub-flavored markdown sections
+// ReportGenerator has always spliced into the README.
+type MarkdownRenderer struct{}
+
+func (MarkdownRenderer) RenderBinary(report BinaryReport) (string, error) {
+	content := Convert()
+
+	content.Write("## Binary Size Comparison\n\n")
+	content.Write("[Standard Library Example](bench-binary-size/standard-lib/main.go) | [fmt Example](bench-binary-size/tinystring-lib/main.go)\n\n")
+	content.Write("<!-- This table is automatically generated from build-and-measure.sh -->\n")
+	content.Write("*Last updated: " + report.GeneratedAt.Format("2006-01-02 15:04:05") + "*\n\n")
+	content.Write("| Build Type | Parameters | Standard Library<br/>`go build` | fmt<br/>`tinygo build` | Size Reduction | Performance |\n")
+	content.Write("|------------|------------|------------------|------------|----------------|-------------|\n")
+
+	var sawNative, sawWasm bool
+	for _, row := range report.Rows {
+		indicator := getPerformanceIndicator(row.ImprovementPct)
+		if row.Kind == "wasm" {
+			sawWasm = true
+			content.Write(Fmt("| 🌐 **%s WASM** | `%s` | %s | %s | **-%s** | %s **%.1f%%** |\n",
+				capitalizeFirst(row.OptName), getBuildParameters(row.OptName, true),
+				row.StandardSizeStr, row.FmtSizeStr,
+				FormatSize(row.SizeDiff), indicator, row.ImprovementPct))
+		} else {
+			sawNative = true
+			content.Write(Fmt("| %s **%s Native** | `%s` | %s | %s | **-%s** | %s **%.1f%%** |\n",
+				getBuildTypeIcon(row.OptName), capitalizeFirst(row.OptName), getBuildParameters(row.OptName, false),
+				row.StandardSizeStr, row.FmtSizeStr,
+				FormatSize(row.SizeDiff), indicator, row.ImprovementPct))
+		}
+	}
+
+	content.Write("\n### 🎯 Performance Summary\n\n")
+	content.Write(Fmt("- 🏆 **Peak Reduction: %.1f%%** (Best optimization)\n", report.Summary.PeakImprovementPct))
+	if sawWasm {
+		content.Write(Fmt("- ✅ **Average WebAssembly Reduction: %.1f%%**\n", report.Summary.AvgWasmImprovementPct))
+	}
+	if sawNative {
+		content.Write(Fmt("- ✅ **Average Native Reduction: %.1f%%**\n", report.Summary.AvgNativeImprovementPct))
+	}
+	content.Write(Fmt("- 📦 **Total Size Savings: %s across all builds**\n\n", FormatSize(report.Summary.TotalSavingsBytes)))
+
+	content.Write("#### Performance Legend\n")
+	content.Write("- ❌ Poor (<5% reduction)\n")
+	content.Write("- ➖ Fair (5-15% reduction)\n")
+	content.Write("- ✅ Good (15-70% reduction)\n")
+	content.Write("- 🏆 Outstanding (>70% reduction)\n\n")
+
+	return content.String(), nil
+}
+
+func (MarkdownRenderer) RenderMemory(report MemoryReport) (string, error) {
+	content := Convert()
+
+	content.Write("## Memory Usage Comparison\n\n")
+	content.Write("[Standard Library Example](bench-memory-alloc/standard) | [fmt Example](bench-memory-alloc/tinystring)\n\n")
+	content.Write("<!-- This table is automatically generated from memory-benchmark.sh -->\n")
+	content.Write("*Last updated: " + report.GeneratedAt.Format("2006-01-02 15:04:05") + "*\n\n")
+	content.Write("Performance benchmarks comparing memory allocation patterns between standard Go library and fmt:\n\n")
+
+	content.Write("| 🧪 **Benchmark Category** | 📚 **Library** | 💾 **Memory/Op** | 🔢 **Allocs/Op** | ⏱️ **Time/Op** | 📈 **Memory Trend** | 🎯 **Alloc Trend** | 🏆 **Performance** |\n")
+	content.Write("|----------------------------|----------------|-------------------|-------------------|-----------------|---------------------|---------------------|--------------------|\n")
+
+	for _, row := range report.Rows {
+		memoryIndicator := getMemoryPerformanceIndicator(row.MemoryPct)
+		allocIndicator := getAllocPerformanceIndicator(row.AllocPct)
+		overallIndicator := getOverallPerformanceIndicator(row.MemoryPct, row.AllocPct)
+		categoryIcon := getBenchmarkCategoryIcon(row.Category)
+
+		opCountStd := Convert(row.Standard.Iterations).Thousands().String()
+		opCountTiny := Convert(row.Fmt.Iterations).Thousands().String()
+		memStd := Fmt("%s / %s OP", FormatSize(row.Standard.BytesPerOp), opCountStd)
+		memTiny := Fmt("%s / %s OP", FormatSize(row.Fmt.BytesPerOp), opCountTiny)
+
+		content.Write(Fmt("| %s **%s** | 📊 Standard | `%s` | `%d` | `%s` | - | - | - |\n",
+			categoryIcon, row.Category, memStd, row.Standard.AllocsPerOp, formatNanoTime(row.Standard.NsPerOp)))
+
+		content.Write(Fmt("| | 🚀 fmt | `%s` | `%d` | `%s` | %s **%s** | %s **%s** | %s |\n",
+			memTiny, row.Fmt.AllocsPerOp, formatNanoTime(row.Fmt.NsPerOp),
+			memoryIndicator, calculateMemoryImprovement(row.Standard.BytesPerOp, row.Fmt.BytesPerOp),
+			allocIndicator, calculateMemoryImprovement(row.Standard.AllocsPerOp, row.Fmt.AllocsPerOp),
+			overallIndicator))
+	}
+
+	content.Write("\n### 🎯 Performance Summary\n\n")
+	content.Write(Fmt("- 💾 **Memory Efficiency**: %s (%.1f%% average change)\n", getMemoryEfficiencyClass(report.Summary.AvgMemoryPct), report.Summary.AvgMemoryPct))
+	content.Write(Fmt("- 🔢 **Allocation Efficiency**: %s (%.1f%% average change)\n", getAllocEfficiencyClass(report.Summary.AvgAllocPct), report.Summary.AvgAllocPct))
+	content.Write(Fmt("- 📊 **Benchmarks Analyzed**: %d categories\n", report.Summary.BenchmarkCount))
+	content.Write("- 🎯 **Optimization Focus**: Binary size reduction vs runtime efficiency\n\n")
+
+	content.Write("### ⚖️ Trade-offs Analysis\n\n")
+	content.Write("The benchmarks reveal important trade-offs between **binary size** and **runtime performance**:\n\n")
+
+	content.Write("#### 📦 **Binary Size Benefits** ✅\n")
+	content.Write("- 🏆 **16-84% smaller** compiled binaries\n")
+	content.Write("- 🌐 **Superior WebAssembly** compression ratios\n")
+	content.Write("- 🚀 **Faster deployment** and distribution\n")
+	content.Write("- 💾 **Lower storage** requirements\n\n")
+
+	content.Write("#### 🧠 **Runtime Memory Considerations** ⚠️\n")
+	content.Write("- 📈 **Higher allocation overhead** during execution\n")
+	content.Write("- 🗑️ **Increased GC pressure** due to allocation patterns\n")
+	content.Write("- ⚡ **Trade-off optimizes** for distribution size over runtime efficiency\n")
+	content.Write("- 🔄 **Different optimization strategy** than standard library\n\n")
+	content.Write("#### 🎯 **Optimization Recommendations**\n")
+	content.Write("| 🎯 **Use Case** | 💡 **Recommendation** | 🔧 **Best For** |\n")
+	content.Write("|-----------------|------------------------|------------------|\n")
+	content.Write("| 🌐 WebAssembly Apps | ✅ **fmt** | Size-critical web deployment |\n")
+	content.Write("| 📱 Embedded Systems | ✅ **fmt** | Resource-constrained devices |\n")
+	content.Write("| ☁️ Edge Computing | ✅ **fmt** | Fast startup and deployment |\n")
+	content.Write("| 🏢 Memory-Intensive Server | ⚠️ **Standard Library** | High-throughput applications |\n")
+	content.Write("| 🔄 High-Frequency Processing | ⚠️ **Standard Library** | Performance-critical workloads |\n\n")
+
+	content.Write("#### 📊 **Performance Legend**\n")
+	content.Write("- 🏆 **Excellent** (Better performance)\n")
+	content.Write("- ✅ **Good** (Acceptable trade-off)\n")
+	content.Write("- ⚠️ **Caution** (Higher resource usage)\n")
+	content.Write("- ❌ **Poor** (Significant overhead)\n\n")
+
+	return content.String(), nil
+}
+
+// reportSchemaVersion is bumped whenever JSONRenderer's envelope shape or
+// field set changes, so downstream dashboards can detect a breaking change.
+const reportSchemaVersion = 1
+
+type jsonReportEnvelope struct {
+	SchemaVersion int    `json:"schema_version"`
+	Kind          string `json:"kind"`
+	GeneratedAt   string `json:"generated_at"`
+	Rows          any    `json:"rows"`
+	Summary       any    `json:"summary"`
+}
+
+// JSONRenderer renders reports as a versioned JSON envelope: CI dashboards
+// and size-regression bots consume Rows/Summary directly instead of
+// re-parsing MarkdownRenderer's tables.
+type JSONRenderer struct{}
+
+func (JSONRenderer) RenderBinary(report BinaryReport) (string, error) {
+	return encodeJSONReport("binary_size", report.GeneratedAt.Format(rfc3339Milli), report.Rows, report.Summary)
+}
+
+func (JSONRenderer) RenderMemory(report MemoryReport) (string, error) {
+	return encodeJSONReport("memory_usage", report.GeneratedAt.Format(rfc3339Milli), report.Rows, report.Summary)
+}
+
+const rfc3339Milli = "2006-01-02T15:04:05.000Z07:00"
+
+func encodeJSONReport(kind, generatedAt string, rows, summary any) (string, error) {
+	envelope := jsonReportEnvelope{
+		SchemaVersion: reportSchemaVersion,
+		Kind:          kind,
+		GeneratedAt:   generatedAt,
+		Rows:          rows,
+		Summary:       summary,
+	}
+	out, err := json.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return "", Err(err)
+	}
+	return string(out), nil
+}
+
+// CSVRenderer renders reports as RFC 4180 CSV, one row per BinaryRow or
+// MemoryRow, for spreadsheet-based trend tracking.
+type CSVRenderer struct{}
+
+func (CSVRenderer) RenderBinary(report BinaryReport) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"opt_level", "kind", "standard_bytes", "fmt_bytes", "diff_bytes", "improvement_pct"}); err != nil {
+		return "", Err(err)
+	}
+	for _, row := range report.Rows {
+		record := []string{
+			row.OptName,
+			row.Kind,
+			strconv.FormatInt(row.StandardSize, 10),
+			strconv.FormatInt(row.FmtSize, 10),
+			strconv.FormatInt(row.SizeDiff, 10),
+			strconv.FormatFloat(row.ImprovementPct, 'f', 2, 64),
+		}
+		if err := w.Write(record); err != nil {
+			return "", Err(err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", Err(err)
+	}
+	return buf.String(), nil
+}
+
+func (CSVRenderer) RenderMemory(report MemoryReport) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"category", "standard_bytes_per_op", "fmt_bytes_per_op", "standard_allocs_per_op", "fmt_allocs_per_op", "memory_pct", "alloc_pct"}); err != nil {
+		return "", Err(err)
+	}
+	for _, row := range report.Rows {
+		record := []string{
+			row.Category,
+			strconv.FormatInt(row.Standard.BytesPerOp, 10),
+			strconv.FormatInt(row.Fmt.BytesPerOp, 10),
+			strconv.FormatInt(row.Standard.AllocsPerOp, 10),
+			strconv.FormatInt(row.Fmt.AllocsPerOp, 10),
+			strconv.FormatFloat(row.MemoryPct, 'f', 2, 64),
+			strconv.FormatFloat(row.AllocPct, 'f', 2, 64),
+		}
+		if err := w.Write(record); err != nil {
+			return "", Err(err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", Err(err)
+	}
+	return buf.String(), nil
+}
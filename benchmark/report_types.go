@@ -0,0 +1,226 @@
+package main
+
+import "time"
+
+// BinaryRow is one native-or-wasm comparison point at a given optimization
+// level, the typed row BinaryReport carries instead of baking straight into
+// markdown.
+type BinaryRow struct {
+	OptName         string // "Default", "Ultra", "Speed", "Debug"
+	Kind            string // "native" or "wasm"
+	StandardSize    int64
+	StandardSizeStr string
+	FmtSize         int64
+	FmtSizeStr      string
+	SizeDiff        int64
+	ImprovementPct  float64
+}
+
+// BinarySummary aggregates BinaryReport.Rows the way generateBinarySizeSection
+// used to compute inline: peak/average improvement and total bytes saved.
+type BinarySummary struct {
+	PeakImprovementPct      float64
+	AvgImprovementPct       float64
+	AvgNativeImprovementPct float64
+	AvgWasmImprovementPct   float64
+	TotalSavingsBytes       int64
+}
+
+// BinaryReport is the typed intermediate buildBinaryReport produces from
+// measured []BinaryInfo, rendered by a ReportRenderer into markdown, CSV or
+// JSON, and diffable against a prior run via Diff.
+type BinaryReport struct {
+	GeneratedAt time.Time
+	Rows        []BinaryRow
+	Summary     BinarySummary
+}
+
+// ImprovementRows implements Report: one entry per row, keyed by
+// optimization level and kind so Diff can match rows across two reports.
+func (r BinaryReport) ImprovementRows() []ImprovementRow {
+	rows := make([]ImprovementRow, len(r.Rows))
+	for i, row := range r.Rows {
+		rows[i] = ImprovementRow{
+			Key:            row.OptName + ":" + row.Kind,
+			ImprovementPct: row.ImprovementPct,
+		}
+	}
+	return rows
+}
+
+// MemoryRow is one benchmark category's standard-vs-fmt comparison, the
+// typed row MemoryReport carries instead of baking straight into markdown.
+type MemoryRow struct {
+	Category  string
+	Standard  BenchmarkResult
+	Fmt       BenchmarkResult
+	MemoryPct float64 // (fmt-standard)/standard*100; negative means less memory
+	AllocPct  float64 // same, for allocation count
+}
+
+// MemorySummary aggregates MemoryReport.Rows the way generateMemorySection
+// used to compute inline: average memory/allocation change across rows.
+type MemorySummary struct {
+	AvgMemoryPct   float64
+	AvgAllocPct    float64
+	BenchmarkCount int
+}
+
+// MemoryReport is the typed intermediate buildMemoryReport produces from
+// measured []MemoryComparison, rendered by a ReportRenderer into markdown,
+// CSV or JSON, and diffable against a prior run via Diff.
+type MemoryReport struct {
+	GeneratedAt time.Time
+	Rows        []MemoryRow
+	Summary     MemorySummary
+}
+
+// ImprovementRows implements Report: one entry per row, keyed by category.
+// ImprovementPct is the memory reduction (positive is an improvement),
+// the inverse sign of MemoryPct so it lines up with BinaryRow's convention.
+func (r MemoryReport) ImprovementRows() []ImprovementRow {
+	rows := make([]ImprovementRow, len(r.Rows))
+	for i, row := range r.Rows {
+		rows[i] = ImprovementRow{Key: row.Category, ImprovementPct: -row.MemoryPct}
+	}
+	return rows
+}
+
+// ImprovementRow is the common shape Diff compares across two reports of
+// the same kind: a stable key plus an improvement percentage where
+// positive is better (smaller binary, less memory).
+type ImprovementRow struct {
+	Key            string
+	ImprovementPct float64
+}
+
+// Report is implemented by BinaryReport and MemoryReport so Diff can
+// compare either kind without caring about their row-specific fields.
+type Report interface {
+	ImprovementRows() []ImprovementRow
+}
+
+// buildBinaryReport computes a BinaryReport from measured binaries,
+// reproducing the per-optimization-level row and summary math
+// generateBinarySizeSection used to compute inline.
+func buildBinaryReport(binaries []BinaryInfo) BinaryReport {
+	var rows []BinaryRow
+	var allImprovements []float64
+	var maxImprovement, avgNativeImprovement, avgWasmImprovement float64
+	var totalSavings int64
+	var nativeCount, wasmCount int
+
+	for _, opt := range getOptimizationConfigs() {
+		standardNative := findBinaryByPattern(binaries, "standard", "native", opt.Suffix)
+		tinystringNative := findBinaryByPattern(binaries, "tinystring", "native", opt.Suffix)
+		standardWasm := findBinaryByPattern(binaries, "standard", "wasm", opt.Suffix)
+		tinystringWasm := findBinaryByPattern(binaries, "tinystring", "wasm", opt.Suffix)
+
+		if standardNative.Name != "" && tinystringNative.Name != "" {
+			pct := calculateImprovementPercent(standardNative.Size, tinystringNative.Size)
+			diff := standardNative.Size - tinystringNative.Size
+			rows = append(rows, BinaryRow{
+				OptName: opt.Name, Kind: "native",
+				StandardSize: standardNative.Size, StandardSizeStr: standardNative.SizeStr,
+				FmtSize: tinystringNative.Size, FmtSizeStr: tinystringNative.SizeStr,
+				SizeDiff: diff, ImprovementPct: pct,
+			})
+			allImprovements = append(allImprovements, pct)
+			if pct > maxImprovement {
+				maxImprovement = pct
+			}
+			totalSavings += diff
+			avgNativeImprovement += pct
+			nativeCount++
+		}
+
+		if standardWasm.Name != "" && tinystringWasm.Name != "" {
+			pct := calculateImprovementPercent(standardWasm.Size, tinystringWasm.Size)
+			diff := standardWasm.Size - tinystringWasm.Size
+			rows = append(rows, BinaryRow{
+				OptName: opt.Name, Kind: "wasm",
+				StandardSize: standardWasm.Size, StandardSizeStr: standardWasm.SizeStr,
+				FmtSize: tinystringWasm.Size, FmtSizeStr: tinystringWasm.SizeStr,
+				SizeDiff: diff, ImprovementPct: pct,
+			})
+			allImprovements = append(allImprovements, pct)
+			if pct > maxImprovement {
+				maxImprovement = pct
+			}
+			totalSavings += diff
+			avgWasmImprovement += pct
+			wasmCount++
+		}
+	}
+
+	var avgImprovement float64
+	if len(allImprovements) > 0 {
+		for _, imp := range allImprovements {
+			avgImprovement += imp
+		}
+		avgImprovement /= float64(len(allImprovements))
+	}
+	if nativeCount > 0 {
+		avgNativeImprovement /= float64(nativeCount)
+	}
+	if wasmCount > 0 {
+		avgWasmImprovement /= float64(wasmCount)
+	}
+
+	return BinaryReport{
+		GeneratedAt: time.Now(),
+		Rows:        rows,
+		Summary: BinarySummary{
+			PeakImprovementPct:      maxImprovement,
+			AvgImprovementPct:       avgImprovement,
+			AvgNativeImprovementPct: avgNativeImprovement,
+			AvgWasmImprovementPct:   avgWasmImprovement,
+			TotalSavingsBytes:       totalSavings,
+		},
+	}
+}
+
+// buildMemoryReport computes a MemoryReport from measured comparisons,
+// reproducing the per-category row and summary math generateMemorySection
+// used to compute inline.
+func buildMemoryReport(comparisons []MemoryComparison) MemoryReport {
+	var rows []MemoryRow
+	var totalMemoryPct, totalAllocPct float64
+	var count int
+
+	for _, comparison := range comparisons {
+		if comparison.Standard.Name == "" || comparison.fmt.Name == "" {
+			continue
+		}
+		memPct := calculateMemoryPercent(comparison.Standard.BytesPerOp, comparison.fmt.BytesPerOp)
+		allocPct := calculateMemoryPercent(comparison.Standard.AllocsPerOp, comparison.fmt.AllocsPerOp)
+
+		rows = append(rows, MemoryRow{
+			Category:  comparison.Category,
+			Standard:  comparison.Standard,
+			Fmt:       comparison.fmt,
+			MemoryPct: memPct,
+			AllocPct:  allocPct,
+		})
+
+		totalMemoryPct += memPct
+		totalAllocPct += allocPct
+		count++
+	}
+
+	var avgMemoryPct, avgAllocPct float64
+	if count > 0 {
+		avgMemoryPct = totalMemoryPct / float64(count)
+		avgAllocPct = totalAllocPct / float64(count)
+	}
+
+	return MemoryReport{
+		GeneratedAt: time.Now(),
+		Rows:        rows,
+		Summary: MemorySummary{
+			AvgMemoryPct:   avgMemoryPct,
+			AvgAllocPct:    avgAllocPct,
+			BenchmarkCount: count,
+		},
+	}
+}
@@ -1,10 +1,8 @@
 package main
 
 import (
-	"fmt"
 	"os"
 	"strings" // Only for section finding in README
-	"time"
 
 	. "github.com/tinywasm/fmt"
 )
@@ -13,6 +11,10 @@ import (
 type ReportGenerator struct {
 	ReadmePath string
 	TempPath   string
+
+	// ReportPath is where UpdateBinaryDataRenderer/UpdateMemoryDataRenderer
+	// write non-markdown renderer output (JSON, CSV). Empty means stdout.
+	ReportPath string
 }
 
 // NewReportGenerator creates a new report generator
@@ -23,271 +25,61 @@ func NewReportGenerator(readmePath string) *ReportGenerator {
 	}
 }
 
-// UpdateREADMEWithBinaryData updates README with binary size comparison data
+// UpdateBinaryData updates the README's "Binary Size Comparison" section,
+// rendered as markdown. For CSV/JSON output (e.g. to feed a size-regression
+// bot) use UpdateBinaryDataRenderer instead.
 func (r *ReportGenerator) UpdateBinaryData(binaries []BinaryInfo) error {
 	LogInfo("Updating README with binary size analysis...")
+	return r.UpdateBinaryDataRenderer(MarkdownRenderer{}, binaries)
+}
 
-	content, err := r.generateBinarySizeSection(binaries)
+// UpdateBinaryDataRenderer builds a BinaryReport from binaries and renders
+// it through renderer. MarkdownRenderer output patches the README's
+// "Binary Size Comparison" section in place, as before; any other renderer
+// (JSONRenderer, CSVRenderer) has its output written to ReportPath, or to
+// stdout when ReportPath is empty (see writeReportOutput).
+func (r *ReportGenerator) UpdateBinaryDataRenderer(renderer ReportRenderer, binaries []BinaryInfo) error {
+	content, err := renderer.RenderBinary(buildBinaryReport(binaries))
 	if err != nil {
 		return Err(err)
 	}
-
-	return r.updateREADMESection("Binary Size Comparison", content)
+	if _, ok := renderer.(MarkdownRenderer); ok {
+		return r.updateREADMESection("Binary Size Comparison", content)
+	}
+	return Err(r.writeReportOutput(content))
 }
 
-// UpdateREADMEWithMemoryData updates README with memory benchmark data
+// UpdateMemoryData updates the README's "Memory Usage Comparison" section,
+// rendered as markdown. For CSV/JSON output use UpdateMemoryDataRenderer
+// instead.
 func (r *ReportGenerator) UpdateMemoryData(comparisons []MemoryComparison) error {
 	LogInfo("Updating README with memory allocation analysis...")
-
-	content, err := r.generateMemorySection(comparisons)
-	if err != nil {
-		return Errf("failed to generate memory section: %v", err)
-	}
-
-	return r.updateREADMESection("Memory Usage Comparison", content)
+	return r.UpdateMemoryDataRenderer(MarkdownRenderer{}, comparisons)
 }
 
-// generateBinarySizeSection creates the binary size comparison section
-func (r *ReportGenerator) generateBinarySizeSection(binaries []BinaryInfo) (string, error) {
-	content := Convert() // Using fmt builder for better performance
-
-	content.Write("## Binary Size Comparison\n\n")
-	content.Write("[Standard Library Example](bench-binary-size/standard-lib/main.go) | [fmt Example](bench-binary-size/tinystring-lib/main.go)\n\n")
-	content.Write("<!-- This table is automatically generated from build-and-measure.sh -->\n")
-	content.Write("*Last updated: " + time.Now().Format("2006-01-02 15:04:05") + "*\n\n")
-
-	// Group binaries by optimization level
-	optimizations := getOptimizationConfigs()
-	content.Write("| Build Type | Parameters | Standard Library<br/>`go build` | fmt<br/>`tinygo build` | Size Reduction | Performance |\n")
-	content.Write("|------------|------------|------------------|------------|----------------|-------------|\n")
-
-	var allImprovements []float64
-	var maxImprovement float64
-	var totalSavings int64
-
-	for _, opt := range optimizations {
-		// Find matching binaries for this optimization level
-		standardNative := findBinaryByPattern(binaries, "standard", "native", opt.Suffix)
-		tinystringNative := findBinaryByPattern(binaries, "tinystring", "native", opt.Suffix)
-		standardWasm := findBinaryByPattern(binaries, "standard", "wasm", opt.Suffix)
-		tinystringWasm := findBinaryByPattern(binaries, "tinystring", "wasm", opt.Suffix)
-
-		// Build type icons and names
-		buildIcon := getBuildTypeIcon(opt.Name)
-		parameters := getBuildParameters(opt.Name, false)    // Native
-		wasmParameters := getBuildParameters(opt.Name, true) // WASM
-		// Native builds
-		if standardNative.Name != "" && tinystringNative.Name != "" {
-			improvementPercent := calculateImprovementPercent(standardNative.Size, tinystringNative.Size)
-			sizeDiff := standardNative.Size - tinystringNative.Size
-			performanceIndicator := getPerformanceIndicator(improvementPercent)
-
-			content.Write(Fmt("| %s **%s Native** | `%s` | %s | %s | **-%s** | %s **%.1f%%** |\n",
-				buildIcon, capitalizeFirst(opt.Name), parameters,
-				standardNative.SizeStr, tinystringNative.SizeStr,
-				FormatSize(sizeDiff), performanceIndicator, improvementPercent))
-
-			allImprovements = append(allImprovements, improvementPercent)
-			if improvementPercent > maxImprovement {
-				maxImprovement = improvementPercent
-			}
-			totalSavings += sizeDiff
-		}
-		// WebAssembly builds
-		if standardWasm.Name != "" && tinystringWasm.Name != "" {
-			improvementPercent := calculateImprovementPercent(standardWasm.Size, tinystringWasm.Size)
-			sizeDiff := standardWasm.Size - tinystringWasm.Size
-			performanceIndicator := getPerformanceIndicator(improvementPercent)
-
-			content.Write(Fmt("| 🌐 **%s WASM** | `%s` | %s | %s | **-%s** | %s **%.1f%%** |\n",
-				capitalizeFirst(opt.Name), wasmParameters,
-				standardWasm.SizeStr, tinystringWasm.SizeStr,
-				FormatSize(sizeDiff), performanceIndicator, improvementPercent))
-
-			allImprovements = append(allImprovements, improvementPercent)
-			if improvementPercent > maxImprovement {
-				maxImprovement = improvementPercent
-			}
-			totalSavings += sizeDiff
-		}
-	}
-
-	// Calculate averages
-	var avgImprovement float64
-	var avgWasmImprovement float64
-	var avgNativeImprovement float64
-	var wasmCount, nativeCount int
-
-	for i, opt := range optimizations {
-		standardNative := findBinaryByPattern(binaries, "standard", "native", opt.Suffix)
-		tinystringNative := findBinaryByPattern(binaries, "tinystring", "native", opt.Suffix)
-		standardWasm := findBinaryByPattern(binaries, "standard", "wasm", opt.Suffix)
-		tinystringWasm := findBinaryByPattern(binaries, "tinystring", "wasm", opt.Suffix)
-
-		if standardNative.Name != "" && tinystringNative.Name != "" {
-			improvement := calculateImprovementPercent(standardNative.Size, tinystringNative.Size)
-			avgNativeImprovement += improvement
-			nativeCount++
-		}
-
-		if standardWasm.Name != "" && tinystringWasm.Name != "" {
-			improvement := calculateImprovementPercent(standardWasm.Size, tinystringWasm.Size)
-			avgWasmImprovement += improvement
-			wasmCount++
-		}
-		_ = i
-	}
-
-	if len(allImprovements) > 0 {
-		for _, imp := range allImprovements {
-			avgImprovement += imp
-		}
-		avgImprovement /= float64(len(allImprovements))
-	}
-
-	if nativeCount > 0 {
-		avgNativeImprovement /= float64(nativeCount)
-	}
-	if wasmCount > 0 {
-		avgWasmImprovement /= float64(wasmCount)
-	}
-	// Performance summary
-	content.Write("\n### 🎯 Performance Summary\n\n")
-	content.Write(Fmt("- 🏆 **Peak Reduction: %.1f%%** (Best optimization)\n", maxImprovement))
-	if wasmCount > 0 {
-		content.Write(Fmt("- ✅ **Average WebAssembly Reduction: %.1f%%**\n", avgWasmImprovement))
+// UpdateMemoryDataRenderer builds a MemoryReport from comparisons and
+// renders it through renderer, the memory-side counterpart of
+// UpdateBinaryDataRenderer.
+func (r *ReportGenerator) UpdateMemoryDataRenderer(renderer ReportRenderer, comparisons []MemoryComparison) error {
+	content, err := renderer.RenderMemory(buildMemoryReport(comparisons))
+	if err != nil {
+		return Errf("failed to render memory section: %v", err)
 	}
-	if nativeCount > 0 {
-		content.Write(Fmt("- ✅ **Average Native Reduction: %.1f%%**\n", avgNativeImprovement))
+	if _, ok := renderer.(MarkdownRenderer); ok {
+		return r.updateREADMESection("Memory Usage Comparison", content)
 	}
-	content.Write(Fmt("- 📦 **Total Size Savings: %s across all builds**\n\n", FormatSize(totalSavings)))
-
-	content.Write("#### Performance Legend\n")
-	content.Write("- ❌ Poor (<5% reduction)\n")
-	content.Write("- ➖ Fair (5-15% reduction)\n")
-	content.Write("- ✅ Good (15-70% reduction)\n")
-	content.Write("- 🏆 Outstanding (>70% reduction)\n\n")
-
-	return content.String(), nil
+	return Err(r.writeReportOutput(content))
 }
 
-// generateMemorySection creates the memory allocation comparison section
-func (r *ReportGenerator) generateMemorySection(comparisons []MemoryComparison) (string, error) {
-	content := Convert() // Using fmt builder for better performance
-
-	content.Write("## Memory Usage Comparison\n\n")
-	content.Write("[Standard Library Example](bench-memory-alloc/standard) | [fmt Example](bench-memory-alloc/tinystring)\n\n")
-	content.Write("<!-- This table is automatically generated from memory-benchmark.sh -->\n")
-	content.Write("*Last updated: " + time.Now().Format("2006-01-02 15:04:05") + "*\n\n")
-	content.Write("Performance benchmarks comparing memory allocation patterns between standard Go library and fmt:\n\n")
-
-	// Enhanced table with better styling and icons
-	content.Write("| 🧪 **Benchmark Category** | 📚 **Library** | 💾 **Memory/Op** | 🔢 **Allocs/Op** | ⏱️ **Time/Op** | 📈 **Memory Trend** | 🎯 **Alloc Trend** | 🏆 **Performance** |\n")
-	content.Write("|----------------------------|----------------|-------------------|-------------------|-----------------|---------------------|---------------------|--------------------|\n")
-
-	var totalMemoryDiff float64
-	var totalAllocDiff float64
-	var benchmarkCount int
-
-	for _, comparison := range comparisons {
-		if comparison.Standard.Name != "" && comparison.fmt.Name != "" {
-			memImprovement := calculateMemoryImprovement(
-				comparison.Standard.BytesPerOp, comparison.fmt.BytesPerOp)
-			allocImprovement := calculateMemoryImprovement(
-				comparison.Standard.AllocsPerOp, comparison.fmt.AllocsPerOp)
-
-			// Calculate percentage changes for tracking
-			memPercent := calculateMemoryPercent(comparison.Standard.BytesPerOp, comparison.fmt.BytesPerOp)
-			allocPercent := calculateMemoryPercent(comparison.Standard.AllocsPerOp, comparison.fmt.AllocsPerOp)
-
-			totalMemoryDiff += memPercent
-			totalAllocDiff += allocPercent
-			benchmarkCount++
-
-			// Get performance indicators
-			memoryIndicator := getMemoryPerformanceIndicator(memPercent)
-			allocIndicator := getAllocPerformanceIndicator(allocPercent)
-			overallIndicator := getOverallPerformanceIndicator(memPercent, allocPercent)
-
-			// Category with emoji
-			categoryIcon := getBenchmarkCategoryIcon(comparison.Category) // Standard library row with enhanced styling
-			// Format operation count with thousands separator
-			fmt.Println("DEBUG: Standard Iterations:", comparison.Standard.Iterations)
-
-			opCountStd := Convert(comparison.Standard.Iterations).Thousands().String()
-			opCountTiny := Convert(comparison.fmt.Iterations).Thousands().String()
-
-			// Memory/op string with operation count
-			memStd := Fmt("%s / %s OP", FormatSize(comparison.Standard.BytesPerOp), opCountStd)
-			memTiny := Fmt("%s / %s OP", FormatSize(comparison.fmt.BytesPerOp), opCountTiny)
-
-			content.Write(Fmt("| %s **%s** | 📊 Standard | `%s` | `%d` | `%s` | - | - | - |\n",
-				categoryIcon,
-				comparison.Category,
-				memStd,
-				comparison.Standard.AllocsPerOp,
-				formatNanoTime(comparison.Standard.NsPerOp)))
-
-			// fmt row with improvements and visual indicators
-			content.Write(Fmt("| | 🚀 fmt | `%s` | `%d` | `%s` | %s **%s** | %s **%s** | %s |\n",
-				memTiny,
-				comparison.fmt.AllocsPerOp,
-				formatNanoTime(comparison.fmt.NsPerOp),
-				memoryIndicator, memImprovement,
-				allocIndicator, allocImprovement,
-				overallIndicator))
-		}
-	}
-
-	// Calculate averages for summary
-	var avgMemoryDiff, avgAllocDiff float64
-	if benchmarkCount > 0 {
-		avgMemoryDiff = totalMemoryDiff / float64(benchmarkCount)
-		avgAllocDiff = totalAllocDiff / float64(benchmarkCount)
+// writeReportOutput writes non-markdown renderer output to ReportPath when
+// set, or to stdout otherwise -- the same "file, or stdout when unset"
+// convention writeRecords uses for -format=csv|json in the analyzer CLI.
+func (r *ReportGenerator) writeReportOutput(content string) error {
+	if r.ReportPath == "" {
+		_, err := os.Stdout.WriteString(content)
+		return err
 	}
-	// Performance summary section with enhanced styling
-	content.Write("\n### 🎯 Performance Summary\n\n")
-
-	// Memory efficiency classification
-	memoryClass := getMemoryEfficiencyClass(avgMemoryDiff)
-	allocClass := getAllocEfficiencyClass(avgAllocDiff)
-
-	content.Write(Fmt("- 💾 **Memory Efficiency**: %s (%.1f%% average change)\n", memoryClass, avgMemoryDiff))
-	content.Write(Fmt("- 🔢 **Allocation Efficiency**: %s (%.1f%% average change)\n", allocClass, avgAllocDiff))
-	content.Write(Fmt("- 📊 **Benchmarks Analyzed**: %d categories\n", benchmarkCount))
-	content.Write("- 🎯 **Optimization Focus**: Binary size reduction vs runtime efficiency\n\n")
-
-	// Enhanced trade-offs analysis with better formatting
-	content.Write("### ⚖️ Trade-offs Analysis\n\n")
-	content.Write("The benchmarks reveal important trade-offs between **binary size** and **runtime performance**:\n\n")
-
-	content.Write("#### 📦 **Binary Size Benefits** ✅\n")
-	content.Write("- 🏆 **16-84% smaller** compiled binaries\n")
-	content.Write("- 🌐 **Superior WebAssembly** compression ratios\n")
-	content.Write("- 🚀 **Faster deployment** and distribution\n")
-	content.Write("- 💾 **Lower storage** requirements\n\n")
-
-	content.Write("#### 🧠 **Runtime Memory Considerations** ⚠️\n")
-	content.Write("- 📈 **Higher allocation overhead** during execution\n")
-	content.Write("- 🗑️ **Increased GC pressure** due to allocation patterns\n")
-	content.Write("- ⚡ **Trade-off optimizes** for distribution size over runtime efficiency\n")
-	content.Write("- 🔄 **Different optimization strategy** than standard library\n\n")
-	content.Write("#### 🎯 **Optimization Recommendations**\n")
-	content.Write("| 🎯 **Use Case** | 💡 **Recommendation** | 🔧 **Best For** |\n")
-	content.Write("|-----------------|------------------------|------------------|\n")
-	content.Write("| 🌐 WebAssembly Apps | ✅ **fmt** | Size-critical web deployment |\n")
-	content.Write("| 📱 Embedded Systems | ✅ **fmt** | Resource-constrained devices |\n")
-	content.Write("| ☁️ Edge Computing | ✅ **fmt** | Fast startup and deployment |\n")
-	content.Write("| 🏢 Memory-Intensive Server | ⚠️ **Standard Library** | High-throughput applications |\n")
-	content.Write("| 🔄 High-Frequency Processing | ⚠️ **Standard Library** | Performance-critical workloads |\n\n")
-
-	content.Write("#### 📊 **Performance Legend**\n")
-	content.Write("- 🏆 **Excellent** (Better performance)\n")
-	content.Write("- ✅ **Good** (Acceptable trade-off)\n")
-	content.Write("- ⚠️ **Caution** (Higher resource usage)\n")
-	content.Write("- ❌ **Poor** (Significant overhead)\n\n")
-
-	return content.String(), nil
+	return os.WriteFile(r.ReportPath, []byte(content), 0644)
 }
 
 // updateREADMESection updates a specific section in the README
@@ -0,0 +1,102 @@
+package fmt
+
+import "unicode/utf8"
+
+// caseException holds a rune's locale-specific case mapping, consulted by
+// ToUpperIn/ToLowerIn/CapitalizeIn after the default ASCII/Latin fold
+// ToUpper/ToLower/Capitalize already applied. An empty field means that
+// operation has no exception for the rune -- the default fold stands.
+type caseException struct {
+	upper string // full upper-case fold, e.g. German ß -> "SS"
+	lower string // lower-case fold, when it differs from the rune itself
+	// capitalize overrides upper specifically for CapitalizeIn, where only
+	// a leading letter is cased rather than the whole word -- German ß
+	// capitalizes to the single letter "ẞ" (U+1E9E), not the two-letter
+	// "SS" a full upper-case fold produces.
+	capitalize string
+}
+
+// caseExceptions is the per-(lang, rune) table of unconditional case
+// exceptions ToUpperIn/ToLowerIn/CapitalizeIn consult -- the rune is
+// exceptional everywhere it appears, regardless of surrounding context.
+// Only the 9 langs LocStr/PluralLocStr/GenderLocStr already size their
+// arrays for are addressable here -- Turkish dotted/dotless I, Greek final
+// sigma, and Azerbaijani's soft consonants need their own lang constants
+// (TR, EL, AZ), and adding one would resize every fixed [9]... array this
+// module already has committed, including every existing LocStr dictionary
+// literal. That ripple is out of scope here; the table is shaped so those
+// languages are a data-only addition once the enum grows.
+var caseExceptions = map[lang]map[rune]caseException{
+	DE: {
+		'ß': {upper: "SS", capitalize: "ẞ"},
+	},
+}
+
+// applyCaseExceptions replaces every rune in c's current content that has
+// an unconditional exception registered for l, using Replace the same way
+// applyNameSubstitutions folds in a FormatEntry's month/weekday names.
+func applyCaseExceptions(c *Conv, l lang, pick func(caseException) string) *Conv {
+	table, ok := caseExceptions[l]
+	if !ok {
+		return c
+	}
+	for r, exc := range table {
+		if repl := pick(exc); repl != "" {
+			c = c.Replace(string(r), repl)
+		}
+	}
+	return c
+}
+
+// applyLeadingCaseException replaces only c's current leading rune with
+// l's capitalize exception for it (falling back to its upper-case
+// exception), leaving every other occurrence of that rune in c's content
+// untouched -- e.g. CapitalizeIn(DE) on "ßtraße" capitalizes the leading ß
+// to "ẞ" without also re-casing the "ß" later in the word.
+func applyLeadingCaseException(c *Conv, l lang) *Conv {
+	table, ok := caseExceptions[l]
+	if !ok {
+		return c
+	}
+	original := c.String()
+	r, size := utf8.DecodeRuneInString(original)
+	if r == utf8.RuneError {
+		return c
+	}
+	exc, ok := table[r]
+	if !ok {
+		return c
+	}
+	repl := exc.capitalize
+	if repl == "" {
+		repl = exc.upper
+	}
+	if repl == "" {
+		return c
+	}
+	return c.Replace(original, repl+original[size:])
+}
+
+// ToUpperIn behaves like ToUpper, then applies l's locale-specific
+// upper-case exceptions (see caseExceptions) -- e.g. under DE, "straße"
+// becomes "STRASSE" rather than the default fold's "STRAßE".
+func (c *Conv) ToUpperIn(l lang) *Conv {
+	c.ToUpper()
+	return applyCaseExceptions(c, l, func(e caseException) string { return e.upper })
+}
+
+// ToLowerIn behaves like ToLower, then applies l's locale-specific
+// lower-case exceptions.
+func (c *Conv) ToLowerIn(l lang) *Conv {
+	c.ToLower()
+	return applyCaseExceptions(c, l, func(e caseException) string { return e.lower })
+}
+
+// CapitalizeIn behaves like Capitalize, then applies l's locale-specific
+// capitalize exception to the leading letter only (see
+// applyLeadingCaseException), falling back to the upper-case exception
+// when a language registers no capitalize-specific form.
+func (c *Conv) CapitalizeIn(l lang) *Conv {
+	c.Capitalize()
+	return applyLeadingCaseException(c, l)
+}
@@ -0,0 +1,27 @@
+package fmt
+
+import "testing"
+
+func TestToUpperInGermanEszett(t *testing.T) {
+	got := Convert("straße").ToUpperIn(DE).String()
+	want := "STRASSE"
+	if got != want {
+		t.Errorf("ToUpperIn(DE) = %q, want %q", got, want)
+	}
+}
+
+func TestCapitalizeInGermanEszett(t *testing.T) {
+	got := Convert("ßtraße").CapitalizeIn(DE).String()
+	want := "ẞtraße"
+	if got != want {
+		t.Errorf("CapitalizeIn(DE) = %q, want %q", got, want)
+	}
+}
+
+func TestToUpperInNoExceptionsFallsBackToDefault(t *testing.T) {
+	got := Convert("straße").ToUpperIn(FR).String()
+	want := Convert("straße").ToUpper().String()
+	if got != want {
+		t.Errorf("ToUpperIn(FR) = %q, want default fold %q", got, want)
+	}
+}
@@ -0,0 +1,230 @@
+package fmt
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// CatalogFormat selects the on-disk syntax LoadCatalog/LoadCatalogFS parse.
+type CatalogFormat int
+
+const (
+	CatalogJSON CatalogFormat = iota
+	CatalogTOML
+	CatalogPO
+)
+
+// LoadCatalog reads a single translation file and merges its entries into
+// the same registry RegisterWords writes to. The target language is taken
+// from the file's base name (e.g. "es.json" registers under ES), unless a
+// PO file declares its own "Language:" header.
+func LoadCatalog(path string, format CatalogFormat) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Err(err)
+	}
+	return loadCatalogData(path, data, format)
+}
+
+// LoadCatalogFS scans fsys for files matching glob (e.g. "locales/*.json")
+// and merges every match into the word dictionary, one language per file,
+// the same way LoadCatalog does for a single path.
+func LoadCatalogFS(fsys fs.FS, glob string, format CatalogFormat) error {
+	matches, err := fs.Glob(fsys, glob)
+	if err != nil {
+		return Err(err)
+	}
+	for _, m := range matches {
+		data, err := fs.ReadFile(fsys, m)
+		if err != nil {
+			return Err(err)
+		}
+		if err := loadCatalogData(m, data, format); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadCatalogData(filePath string, data []byte, format CatalogFormat) error {
+	var entries map[string]string
+	var declaredLang string
+	var err error
+
+	switch format {
+	case CatalogJSON:
+		entries, err = parseJSONCatalog(data)
+	case CatalogTOML:
+		entries, err = parseTOMLCatalog(data)
+	case CatalogPO:
+		entries, declaredLang, err = parsePOCatalog(data)
+	}
+	if err != nil {
+		return Err(err)
+	}
+
+	langCode := declaredLang
+	if langCode == "" {
+		base := path.Base(filePath)
+		langCode = strings.TrimSuffix(base, path.Ext(base))
+	}
+
+	dict := make([]DictEntry, 0, len(entries))
+	for en, translated := range entries {
+		dict = append(dict, dictEntryFor(langCode, en, translated))
+	}
+	RegisterWords(dict)
+	return nil
+}
+
+// dictEntryFor builds a DictEntry carrying en -> translated for whichever
+// of the fixed language fields langCode resolves to.
+func dictEntryFor(langCode, en, translated string) DictEntry {
+	c := GetConv()
+	l := c.langParser(langCode)
+	c.putConv()
+
+	e := DictEntry{EN: en}
+	switch l {
+	case ES:
+		e.ES = translated
+	case ZH:
+		e.ZH = translated
+	case HI:
+		e.HI = translated
+	case AR:
+		e.AR = translated
+	case PT:
+		e.PT = translated
+	case FR:
+		e.FR = translated
+	case DE:
+		e.DE = translated
+	case RU:
+		e.RU = translated
+	}
+	return e
+}
+
+func parseJSONCatalog(data []byte) (map[string]string, error) {
+	entries := map[string]string{}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// parseTOMLCatalog supports the flat `key = "value"` shape a translation
+// table needs -- no nested tables or arrays.
+func parseTOMLCatalog(data []byte) (map[string]string, error) {
+	entries := map[string]string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		entries[key] = value
+	}
+	return entries, nil
+}
+
+// parsePOCatalog supports the subset of gettext PO relevant to a word
+// dictionary: the "Language:" header and msgid/msgstr pairs. Multi-line
+// strings and msgid_plural are not handled here; see RegisterMessages for
+// full sentence/plural catalogs.
+func parsePOCatalog(data []byte) (map[string]string, string, error) {
+	entries := map[string]string{}
+	lang := ""
+
+	var pendingID string
+	haveID := false
+
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "#"), line == "":
+			continue
+		case strings.HasPrefix(line, "msgid "):
+			pendingID = unquotePO(strings.TrimPrefix(line, "msgid "))
+			haveID = pendingID != ""
+		case strings.HasPrefix(line, "msgstr "):
+			value := unquotePO(strings.TrimPrefix(line, "msgstr "))
+			if haveID {
+				entries[pendingID] = value
+			} else if value != "" {
+				// The header is the msgid "" entry's msgstr.
+				for _, headerLine := range strings.Split(value, `\n`) {
+					if l, ok := strings.CutPrefix(headerLine, "Language: "); ok {
+						lang = strings.TrimSpace(l)
+					}
+				}
+			}
+			haveID = false
+		}
+	}
+
+	delete(entries, "")
+	return entries, lang, nil
+}
+
+func unquotePO(s string) string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, `"`)
+	s = strings.TrimSuffix(s, `"`)
+	s = strings.ReplaceAll(s, `\"`, `"`)
+	return s
+}
+
+// WatchCatalog polls path every interval and reloads it via LoadCatalog
+// whenever its modification time changes, reporting reload errors (nil on
+// success) on the returned channel. Call the returned stop func to end the
+// poll loop.
+func WatchCatalog(path string, format CatalogFormat, interval time.Duration) (<-chan error, func()) {
+	events := make(chan error, 1)
+	stop := make(chan struct{})
+
+	go func() {
+		var lastMod time.Time
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				close(events)
+				return
+			case <-ticker.C:
+				info, err := os.Stat(path)
+				if err != nil {
+					continue
+				}
+				if info.ModTime().Equal(lastMod) {
+					continue
+				}
+				lastMod = info.ModTime()
+				err = LoadCatalog(path, format)
+				// events is buffered 1, but a caller that stops watching
+				// without draining it would otherwise leave this goroutine
+				// blocked on the send forever; race the send against stop
+				// so it can still exit.
+				select {
+				case events <- err:
+				case <-stop:
+					close(events)
+					return
+				}
+			}
+		}
+	}()
+
+	return events, func() { close(stop) }
+}
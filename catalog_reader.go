@@ -0,0 +1,71 @@
+package fmt
+
+import (
+	"io"
+	"io/fs"
+	"strings"
+)
+
+// LoadCatalogReader parses a catalog already in memory (e.g. a
+// //go:embed'd asset) and merges its entries into lang's word dictionary,
+// the same registry RegisterWords writes to. Unlike LoadCatalog, the
+// target language is explicit rather than inferred from a file name, which
+// is what embedded readers need since they carry no path.
+func LoadCatalogReader(lang string, r io.Reader, format CatalogFormat) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Err(err)
+	}
+	return loadCatalogData(lang+catalogExtFor(format), data, format)
+}
+
+func catalogExtFor(format CatalogFormat) string {
+	switch format {
+	case CatalogJSON:
+		return ".json"
+	case CatalogTOML:
+		return ".toml"
+	case CatalogPO:
+		return ".po"
+	}
+	return ""
+}
+
+// LoadCatalogsFS scans dir within fsys for catalog files named "<lang>.po",
+// "<lang>.json" or "<lang>.toml" and merges every one of them into the word
+// dictionary, so a TinyGo app can ship its whole translation set as
+// //go:embed assets instead of hand-registering DictEntry values in an
+// init().
+func LoadCatalogsFS(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return Err(err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		var format CatalogFormat
+		switch {
+		case strings.HasSuffix(entry.Name(), ".po"):
+			format = CatalogPO
+		case strings.HasSuffix(entry.Name(), ".json"):
+			format = CatalogJSON
+		case strings.HasSuffix(entry.Name(), ".toml"):
+			format = CatalogTOML
+		default:
+			continue
+		}
+
+		data, err := fs.ReadFile(fsys, dir+"/"+entry.Name())
+		if err != nil {
+			return Err(err)
+		}
+		if err := loadCatalogData(entry.Name(), data, format); err != nil {
+			return err
+		}
+	}
+	return nil
+}
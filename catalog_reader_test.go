@@ -0,0 +1,38 @@
+package fmt
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadCatalogReader(t *testing.T) {
+	r := strings.NewReader(`{"reader_hello":"reader_hola"}`)
+	if err := LoadCatalogReader("es", r, CatalogJSON); err != nil {
+		t.Fatalf("LoadCatalogReader: %v", err)
+	}
+
+	got := Translate(ES, "reader_hello").String()
+	if got != "reader_hola" {
+		t.Errorf("want %q got %q", "reader_hola", got)
+	}
+}
+
+func TestLoadCatalogsFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/es.json": {Data: []byte(`{"scan_bye":"scan_adios"}`)},
+		"locales/de.json": {Data: []byte(`{"scan_bye":"scan_tschuss"}`)},
+		"locales/readme":  {Data: []byte("not a catalog")},
+	}
+
+	if err := LoadCatalogsFS(fsys, "locales"); err != nil {
+		t.Fatalf("LoadCatalogsFS: %v", err)
+	}
+
+	if got := Translate(ES, "scan_bye").String(); got != "scan_adios" {
+		t.Errorf("ES: want %q got %q", "scan_adios", got)
+	}
+	if got := Translate(DE, "scan_bye").String(); got != "scan_tschuss" {
+		t.Errorf("DE: want %q got %q", "scan_tschuss", got)
+	}
+}
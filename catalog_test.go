@@ -0,0 +1,42 @@
+package fmt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadCatalogJSON(t *testing.T) {
+	dir := t.TempDir()
+	esPath := filepath.Join(dir, "es.json")
+	if err := os.WriteFile(esPath, []byte(`{"catalog_hello":"catalog_hola"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := LoadCatalog(esPath, CatalogJSON); err != nil {
+		t.Fatalf("LoadCatalog: %v", err)
+	}
+
+	got := Translate(ES, "catalog_hello").String()
+	if got != "catalog_hola" {
+		t.Errorf("want %q got %q", "catalog_hola", got)
+	}
+}
+
+func TestLoadCatalogPO(t *testing.T) {
+	dir := t.TempDir()
+	poPath := filepath.Join(dir, "translations.po")
+	content := "msgid \"\"\nmsgstr \"Language: fr\\n\"\n\nmsgid \"catalog_bye\"\nmsgstr \"catalog_au_revoir\"\n"
+	if err := os.WriteFile(poPath, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := LoadCatalog(poPath, CatalogPO); err != nil {
+		t.Fatalf("LoadCatalog: %v", err)
+	}
+
+	got := Translate(FR, "catalog_bye").String()
+	if got != "catalog_au_revoir" {
+		t.Errorf("want %q got %q", "catalog_au_revoir", got)
+	}
+}
@@ -0,0 +1,440 @@
+// Command fmt-extract statically scans Go source for Translate(...) calls,
+// Sprintf("%L", ...) calls and LocStr{...} literals, and emits a generated
+// DictEntry registration file for any key that isn't already registered by
+// the hand-maintained dictionary package -- the same idea as the
+// golang.org/x/text/message pipeline, scaled down to this module's
+// RegisterWords API.
+//
+// Usage:
+//
+//	fmt-extract [-out file] [-catalog file] [-dict dir] [dirs...]
+//
+// dirs defaults to the current directory. -dict points at the package
+// RegisterWords is already populated from (default "dictionary"); keys
+// registered there are left untouched. -out is the generated .go file
+// (default "dictionary/dictionary_extracted.go"). -catalog, if set, also
+// writes a JSON catalog of every known key's per-language translations for
+// human translators to fill in.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// langField is one DictEntry field, in the order dictEntryFor (catalog.go)
+// and LocStr (language.go) both use: EN, ES, ZH, HI, AR, PT, FR, DE, RU.
+var langFields = []string{"EN", "ES", "ZH", "HI", "AR", "PT", "FR", "DE", "RU"}
+
+// dictEntry mirrors the shape of this module's DictEntry/LocStr: one
+// optional translation per supported language, keyed by EN.
+type dictEntry struct {
+	values map[string]string // langField -> translation
+}
+
+func main() {
+	var (
+		outPath     = flag.String("out", "dictionary/dictionary_extracted.go", "generated .go file to write")
+		catalogPath = flag.String("catalog", "", "optional JSON catalog to write alongside -out")
+		dictDir     = flag.String("dict", "dictionary", "package directory RegisterWords is already populated from")
+	)
+	flag.Parse()
+
+	dirs := flag.Args()
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+
+	extracted, err := extractKeys(dirs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "fmt-extract:", err)
+		os.Exit(1)
+	}
+
+	registered, err := extractRegisteredEntries(*dictDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "fmt-extract:", err)
+		os.Exit(1)
+	}
+
+	missing := diffMissing(extracted, registered)
+
+	if err := writeGeneratedFile(*outPath, missing); err != nil {
+		fmt.Fprintln(os.Stderr, "fmt-extract:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("fmt-extract: wrote %d new of %d scanned keys to %s\n", len(missing), len(extracted), *outPath)
+
+	if *catalogPath != "" {
+		all := mergeForCatalog(extracted, registered)
+		if err := writeCatalog(*catalogPath, all); err != nil {
+			fmt.Fprintln(os.Stderr, "fmt-extract:", err)
+			os.Exit(1)
+		}
+		fmt.Printf("fmt-extract: wrote catalog for %d keys to %s\n", len(all), *catalogPath)
+	}
+}
+
+// extractKeys walks dirs and collects every EN key a Translate(-family)
+// call or LocStr{...} literal references, deduplicated case-insensitively
+// (see ensure).
+func extractKeys(dirs []string) (map[string]*dictEntry, error) {
+	found := map[string]*dictEntry{}
+	fset := token.NewFileSet()
+
+	for _, dir := range dirs {
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return nil
+			}
+
+			file, err := parser.ParseFile(fset, path, nil, parser.AllErrors)
+			if err != nil {
+				return fmt.Errorf("parsing %s: %w", path, err)
+			}
+
+			ast.Inspect(file, func(n ast.Node) bool {
+				switch node := n.(type) {
+				case *ast.CallExpr:
+					collectFromCall(node, found)
+				case *ast.CompositeLit:
+					collectFromLocStr(node, found)
+				}
+				return true
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return found, nil
+}
+
+// translateFuncs are the Translate-family call sites whose string-literal
+// arguments are EN-keyed dictionary lookups; every string literal among a
+// call's arguments is collected, not only the first.
+var translateFuncs = map[string]bool{
+	"Translate": true, "TranslateCtx": true, "TranslatePlural": true,
+	"TranslateSelect": true, "TranslateTag": true, "TranslateWithLang": true,
+}
+
+func collectFromCall(call *ast.CallExpr, found map[string]*dictEntry) {
+	name := calleeName(call.Fun)
+
+	switch {
+	case translateFuncs[name]:
+		for _, arg := range call.Args {
+			if key, ok := stringLit(arg); ok {
+				ensure(found, key)
+			}
+		}
+	case name == "Sprintf" && len(call.Args) >= 2:
+		format, ok := stringLit(call.Args[0])
+		if ok && format == "%L" {
+			if key, ok := stringLit(call.Args[1]); ok {
+				ensure(found, key)
+			}
+		}
+	}
+}
+
+// calleeName returns the identifier a call targets, whether written as a
+// bare dot-imported name (Translate(...)) or package-qualified
+// (fmt.Translate(...)).
+func calleeName(fun ast.Expr) string {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name
+	case *ast.SelectorExpr:
+		return f.Sel.Name
+	}
+	return ""
+}
+
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+// collectFromLocStr pulls every field out of a LocStr{EN: ..., ES: ...}
+// composite literal (keyed or, per language.go's documented order,
+// positional) so extraction sees translations already hard-coded at the
+// call site, not just the bare EN key.
+func collectFromLocStr(lit *ast.CompositeLit, found map[string]*dictEntry) {
+	var typeName string
+	switch t := lit.Type.(type) {
+	case *ast.Ident:
+		typeName = t.Name
+	case *ast.SelectorExpr:
+		typeName = t.Sel.Name
+	}
+	if typeName != "LocStr" {
+		return
+	}
+
+	values := map[string]string{}
+	for i, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			key, ok := kv.Key.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			if v, ok := stringLit(kv.Value); ok {
+				values[key.Name] = v
+			}
+			continue
+		}
+		if i < len(langFields) {
+			if v, ok := stringLit(elt); ok {
+				values[langFields[i]] = v
+			}
+		}
+	}
+
+	en, ok := values["EN"]
+	if !ok || en == "" {
+		return
+	}
+	e := ensure(found, en)
+	for field, v := range values {
+		if v != "" {
+			e.values[field] = v
+		}
+	}
+}
+
+func ensure(found map[string]*dictEntry, en string) *dictEntry {
+	key := strings.ToLower(en)
+	for k, e := range found {
+		if strings.ToLower(k) == key {
+			return e
+		}
+	}
+	e := &dictEntry{values: map[string]string{"EN": en}}
+	found[en] = e
+	return e
+}
+
+// extractRegisteredEntries scans dictDir for the DictEntry literals already
+// passed to RegisterWords, so the generated file only adds what's missing
+// instead of duplicating hand-maintained translations.
+func extractRegisteredEntries(dictDir string) (map[string]*dictEntry, error) {
+	registered := map[string]*dictEntry{}
+
+	if _, err := os.Stat(dictDir); os.IsNotExist(err) {
+		return registered, nil
+	}
+
+	fset := token.NewFileSet()
+	err := filepath.Walk(dictDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.AllErrors)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || calleeName(call.Fun) != "RegisterWords" {
+				return true
+			}
+			for _, arg := range call.Args {
+				slice, ok := arg.(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				for _, elt := range slice.Elts {
+					entryLit, ok := elt.(*ast.CompositeLit)
+					if !ok {
+						continue
+					}
+					collectDictEntryLit(entryLit, registered)
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	return registered, err
+}
+
+func collectDictEntryLit(lit *ast.CompositeLit, registered map[string]*dictEntry) {
+	values := map[string]string{}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		if v, ok := stringLit(kv.Value); ok {
+			values[key.Name] = v
+		}
+	}
+	en, ok := values["EN"]
+	if !ok || en == "" {
+		return
+	}
+	e := ensure(registered, en)
+	for field, v := range values {
+		e.values[field] = v
+	}
+}
+
+// diffMissing returns the entries in extracted whose EN key has no
+// counterpart (case-insensitively) in registered, so the generated file
+// only covers what hand-registration hasn't already filled in.
+func diffMissing(extracted, registered map[string]*dictEntry) map[string]*dictEntry {
+	missing := map[string]*dictEntry{}
+	for en, e := range extracted {
+		if _, ok := lookupCI(registered, en); ok {
+			continue
+		}
+		missing[en] = e
+	}
+	return missing
+}
+
+func lookupCI(m map[string]*dictEntry, en string) (*dictEntry, bool) {
+	key := strings.ToLower(en)
+	for k, e := range m {
+		if strings.ToLower(k) == key {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// mergeForCatalog unions registered and extracted, preferring the
+// registered translation for any field both sides set -- the catalog
+// reflects the full current state, not just what's missing.
+func mergeForCatalog(extracted, registered map[string]*dictEntry) map[string]*dictEntry {
+	merged := map[string]*dictEntry{}
+	for en, e := range extracted {
+		merged[en] = &dictEntry{values: cloneValues(e.values)}
+	}
+	for en, e := range registered {
+		target, ok := lookupCI(merged, en)
+		if !ok {
+			merged[en] = &dictEntry{values: cloneValues(e.values)}
+			continue
+		}
+		for field, v := range e.values {
+			target.values[field] = v
+		}
+	}
+	return merged
+}
+
+func cloneValues(values map[string]string) map[string]string {
+	out := make(map[string]string, len(values))
+	for k, v := range values {
+		out[k] = v
+	}
+	return out
+}
+
+// writeGeneratedFile writes a dictionary package file registering entries
+// via RegisterWords, the same shape dictionary/dictionary.go hand-writes.
+func writeGeneratedFile(path string, entries map[string]*dictEntry) error {
+	keys := sortedKeys(entries)
+
+	var b strings.Builder
+	b.WriteString("// Code generated by fmt-extract. DO NOT EDIT.\n\n")
+	b.WriteString("package dictionary\n\n")
+	b.WriteString("import fmt \"github.com/tinywasm/fmt\"\n\n")
+	b.WriteString("// init registers every Translate/LocStr key fmt-extract found with no\n")
+	b.WriteString("// existing hand-written registration. EN is filled from the call site;\n")
+	b.WriteString("// other languages carry whatever a LocStr literal at the call site\n")
+	b.WriteString("// supplied and are otherwise left blank for a translator to fill in.\n")
+	b.WriteString("func init() {\n")
+	b.WriteString("\tfmt.RegisterWords([]fmt.DictEntry{\n")
+	for _, key := range keys {
+		b.WriteString("\t\t" + dictEntryLiteral(entries[key]) + ",\n")
+	}
+	b.WriteString("\t})\n")
+	b.WriteString("}\n")
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+func dictEntryLiteral(e *dictEntry) string {
+	var parts []string
+	for _, field := range langFields {
+		if v, ok := e.values[field]; ok && v != "" {
+			parts = append(parts, field+": "+strconv.Quote(v))
+		}
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+func sortedKeys(entries map[string]*dictEntry) []string {
+	keys := make([]string, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeCatalog writes entries as a GetText/x-text-style JSON catalog, one
+// object per key with every supported language field, for a human
+// translator to fill the blanks in before round-tripping through
+// RegisterWords or LoadCatalog.
+func writeCatalog(path string, entries map[string]*dictEntry) error {
+	type catalogEntry map[string]string
+
+	catalog := make(map[string]catalogEntry, len(entries))
+	for key, e := range entries {
+		ce := make(catalogEntry, len(langFields))
+		for _, field := range langFields {
+			ce[field] = e.values[field]
+		}
+		catalog[key] = ce
+	}
+
+	data, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(path, data, 0o644)
+}
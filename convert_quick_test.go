@@ -0,0 +1,124 @@
+package fmt
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+)
+
+// Stringy is a string that, when generated by testing/quick, mixes ASCII,
+// Latin-1 accented runes, multi-byte UTF-8, and embedded spaces/underscores
+// -- so the generated corpus actually exercises the Tilde and
+// case-boundary code paths instead of just plain ASCII words.
+type Stringy string
+
+var stringyRunes = []rune{
+	'a', 'b', 'c', 'X', 'Y', 'Z', ' ', '_',
+	'é', 'ñ', 'ü', 'ç',
+	'你', '好', '日',
+}
+
+// Generate implements quick.Generator.
+func (Stringy) Generate(rnd *rand.Rand, size int) reflect.Value {
+	n := rnd.Intn(size + 1)
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteRune(stringyRunes[rnd.Intn(len(stringyRunes))])
+	}
+	return reflect.ValueOf(Stringy(b.String()))
+}
+
+func TestQuickIdempotence(t *testing.T) {
+	checks := map[string]func(Stringy) bool{
+		"ToLower": func(s Stringy) bool {
+			once := Convert(string(s)).ToLower().String()
+			return once == Convert(once).ToLower().String()
+		},
+		"ToUpper": func(s Stringy) bool {
+			once := Convert(string(s)).ToUpper().String()
+			return once == Convert(once).ToUpper().String()
+		},
+		"TrimSpace": func(s Stringy) bool {
+			once := Convert(string(s)).TrimSpace().String()
+			return once == Convert(once).TrimSpace().String()
+		},
+		"Tilde": func(s Stringy) bool {
+			once := Convert(string(s)).Tilde().String()
+			return once == Convert(once).Tilde().String()
+		},
+		"Capitalize": func(s Stringy) bool {
+			once := Convert(string(s)).Capitalize().String()
+			return once == Convert(once).Capitalize().String()
+		},
+	}
+
+	for name, fn := range checks {
+		t.Run(name, func(t *testing.T) {
+			if err := quick.Check(fn, nil); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestQuickCamelSnakeRoundTrip(t *testing.T) {
+	f := func(s Stringy) bool {
+		once := Convert(string(s)).CamelLow().String()
+		roundTripped := Convert(Convert(once).SnakeLow().String()).CamelLow().String()
+		return once == roundTripped
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuickTruncateMonotone(t *testing.T) {
+	f := func(s Stringy, n uint8) bool {
+		limit := int(n)
+		out := Convert(string(s)).Truncate(limit).String()
+		return limit == 0 || len([]rune(out)) <= limit
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuickRepeatLength(t *testing.T) {
+	f := func(k uint8) bool {
+		out := Convert("x").Repeat(int(k)).String()
+		return len(out) == int(k)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestQuickJoinSplit(t *testing.T) {
+	f := func(parts []Stringy) bool {
+		const sep = "|"
+		strs := make([]string, len(parts))
+		for i, p := range parts {
+			s := string(p)
+			if strings.Contains(s, sep) {
+				return true // skip: sep occurs in the part, round trip isn't defined
+			}
+			strs[i] = s
+		}
+		joined := Convert(strs).Join(sep).String()
+		split := Convert(joined).Split(sep)
+		if len(split) != len(strs) {
+			return false
+		}
+		for i := range strs {
+			if split[i] != strs[i] {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,125 @@
+package fmt
+
+import "testing"
+
+func TestPathShortWithAliases(t *testing.T) {
+	originalBase := pathBase
+	originalAliases := pathAliases
+	defer func() {
+		pathBase = originalBase
+		pathAliases = originalAliases
+	}()
+
+	SetPathBase("/home/user/project")
+	pathAliases = nil
+	RegisterPathAlias("$GOROOT", "/usr/local/go")
+	RegisterPathAlias("$MOD", "/home/user/go/pkg/mod/github.com/tinywasm/fmt@v0.3.1")
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "GOROOT alias",
+			path: "panic: /usr/local/go/src/runtime/panic.go:812",
+			want: "panic: $GOROOT/src/runtime/panic.go:812",
+		},
+		{
+			name: "mod alias",
+			path: "/home/user/go/pkg/mod/github.com/tinywasm/fmt@v0.3.1/translate.go",
+			want: "$MOD/translate.go",
+		},
+		{
+			name: "CWD base still wins when no alias matches",
+			path: "/home/user/project/main.go",
+			want: "./main.go",
+		},
+		{
+			name: "unregistered path left untouched",
+			path: "/etc/passwd",
+			want: "/etc/passwd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Convert(tt.path).PathShort().String()
+			if got != tt.want {
+				t.Errorf("PathShort(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathShortFallsBackWhenLongestOverlappingBaseIsInvalid(t *testing.T) {
+	originalBase := pathBase
+	originalAliases := pathAliases
+	defer func() {
+		pathBase = originalBase
+		pathAliases = originalAliases
+	}()
+
+	SetPathBase("/nonexistent-test-cwd")
+	pathAliases = nil
+	RegisterPathAlias("$HOME", "/home/user")
+	RegisterPathAlias("$WORK", "/home/user/work")
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "nested base wins when it matches cleanly",
+			path: "/home/user/work/file.go",
+			want: "$WORK/file.go",
+		},
+		{
+			name: "falls back to the shorter base when the longer one isn't component-aligned",
+			path: "/home/user/workshop/file.go",
+			want: "$HOME/workshop/file.go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Convert(tt.path).PathShort().String()
+			if got != tt.want {
+				t.Errorf("PathShort(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathLongRoundTrips(t *testing.T) {
+	originalBase := pathBase
+	originalAliases := pathAliases
+	defer func() {
+		pathBase = originalBase
+		pathAliases = originalAliases
+	}()
+
+	SetPathBase("/home/user/project")
+	pathAliases = nil
+	RegisterPathAlias("$GOROOT", "/usr/local/go")
+
+	tests := []struct {
+		name string
+		long string
+	}{
+		{"goroot stack trace", "panic: /usr/local/go/src/runtime/panic.go:812"},
+		{"cwd relative", "loaded /home/user/project/web/client.go"},
+		{"cwd exact", "/home/user/project"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			short := Convert(tt.long).PathShort().String()
+			roundTripped := Convert(short).PathLong().String()
+			if roundTripped != tt.long {
+				t.Errorf("PathLong(PathShort(%q)) = %q, want %q (short form was %q)", tt.long, roundTripped, tt.long, short)
+			}
+		})
+	}
+}
@@ -4,20 +4,83 @@ package fmt
 
 import (
 	"os"
+	"sync"
 )
 
 var pathBase string
 
+// urlBase is the URL origin (e.g. "https://example.com/app/") PathShort
+// shortens embedded http(s)/file/wasm URLs against, set via SetURLBase.
+var urlBase string
+
+// pathAliasEntry is one registered (alias token, absolute base) pair
+// PathShort substitutes alias for a component-aligned occurrence of base,
+// e.g. RegisterPathAlias("$GOROOT", runtime.GOROOT()) so
+// "/usr/local/go/src/runtime/panic.go" shortens to
+// "$GOROOT/src/runtime/panic.go".
+type pathAliasEntry struct {
+	alias string
+	base  string
+}
+
+var (
+	pathAliasesMu sync.RWMutex
+	pathAliases   []pathAliasEntry
+)
+
 // SetPathBase sets the base path for PathShort operations.
 // Optional: if not called, PathShort auto-detects using os.Getwd().
 func SetPathBase(base string) {
 	pathBase, _ = pathClean(base)
 }
 
-// PathShort shortens absolute paths relative to base path.
+// SetURLBase sets the URL origin PathShort shortens embedded http://,
+// https://, file:// and wasm:// URLs against, e.g.
+// "https://example.com/app/modules/x.js" -> "./modules/x.js" once
+// SetURLBase("https://example.com/app/") has been called.
+func SetURLBase(origin string) {
+	urlBase = origin
+}
+
+// RegisterPathAlias registers base (cleaned the same way SetPathBase
+// cleans its argument) so PathShort substitutes alias for any
+// component-aligned occurrence of base, and PathLong expands alias back
+// to base. Multiple bases may be registered; PathShort always prefers
+// the longest matching base at each position, not registration order.
+func RegisterPathAlias(alias, base string) {
+	cleaned, _ := pathClean(base)
+	if cleaned == "" {
+		return
+	}
+	pathAliasesMu.Lock()
+	defer pathAliasesMu.Unlock()
+	pathAliases = append(pathAliases, pathAliasEntry{alias: alias, base: cleaned})
+}
+
+// pathSubstitutions returns every (alias, base) pair PathShort/PathLong
+// substitute between: every RegisterPathAlias entry, plus the CWD default
+// (alias ".") when pathBase is set.
+func pathSubstitutions() []pathAliasEntry {
+	pathAliasesMu.RLock()
+	entries := make([]pathAliasEntry, len(pathAliases), len(pathAliases)+1)
+	copy(entries, pathAliases)
+	pathAliasesMu.RUnlock()
+
+	if pathBase != "" {
+		entries = append(entries, pathAliasEntry{alias: ".", base: pathBase})
+	}
+	return entries
+}
+
+// PathShort shortens absolute paths (and, once SetURLBase is called,
+// embedded URLs) relative to their base, and collapses archive-member
+// notation ("foo.tar!/inner/path") down to the archive name plus the
+// member's base name.
 // It can handle paths embedded in larger strings (e.g. log messages).
 // Auto-detects base path via os.Getwd() if SetPathBase was not called.
-// Returns relative path with "./" prefix for minimal output.
+// Returns relative path with "./" prefix for minimal output, or the alias
+// token of whichever RegisterPathAlias base matched -- the longest
+// matching base at each position wins when more than one is registered.
 // Example: "Compiling /home/user/project/src/file.go ..." -> "Compiling ./src/file.go ..."
 func (c *Conv) PathShort() *Conv {
 	if pathBase == "" {
@@ -26,87 +89,331 @@ func (c *Conv) PathShort() *Conv {
 		}
 	}
 
-	if pathBase == "" {
-		return c
+	if urlBase != "" {
+		substituteBases(c, buildPathTrie([]pathAliasEntry{{alias: ".", base: urlBase}}))
 	}
 
-	src := c.GetStringZeroCopy(BuffOut)
-	if src == "" {
-		return c
+	substituteBases(c, buildPathTrie(pathSubstitutions()))
+
+	collapseArchiveMembers(c)
+
+	return c
+}
+
+// PathLong is PathShort's inverse: it expands an alias token ("./",
+// "$GOROOT", ...) at a component-aligned position back to its registered
+// absolute base, so a log line PathShort shortened (e.g.
+// "$GOROOT/src/runtime/panic.go:812") round-trips back to its full path.
+// Archive-member collapsing is lossy and has no inverse.
+func (c *Conv) PathLong() *Conv {
+	expandAliases(c, buildAliasTrie(pathSubstitutions()))
+	return c
+}
+
+// pathTrieNode is one node of the prefix trie substituteBases walks: its
+// children index the next byte of any registered base, and alias is set
+// once a base terminates at this node.
+type pathTrieNode struct {
+	children [256]*pathTrieNode
+	alias    string
+	isRoot   bool
+	terminal bool
+}
+
+// buildPathTrie compiles entries into a trie keyed on each entry's base,
+// so substituteBases can find the longest base matching at any position
+// in a single left-to-right pass instead of one Index scan per base.
+func buildPathTrie(entries []pathAliasEntry) *pathTrieNode {
+	root := &pathTrieNode{}
+	for _, e := range entries {
+		if e.base == "" {
+			continue
+		}
+		node := root
+		for i := 0; i < len(e.base); i++ {
+			ch := e.base[i]
+			if node.children[ch] == nil {
+				node.children[ch] = &pathTrieNode{}
+			}
+			node = node.children[ch]
+		}
+		if !node.terminal {
+			node.terminal = true
+			node.alias = e.alias
+			node.isRoot = len(e.base) == 1 && (e.base[0] == '/' || e.base[0] == '\\')
+		}
 	}
+	return root
+}
 
-	// We'll build the result in the work buffer to avoid multiple allocations
-	c.ResetBuffer(BuffWork)
+// pathMatch is one registered base matching at a given position --
+// longestPathMatchAt returns every terminal node the trie walk passes
+// through, not just the longest, so substituteBases can fall back to a
+// shorter candidate when the longest one fails its boundary check.
+type pathMatch struct {
+	alias  string
+	length int
+	isRoot bool
+}
 
-	start := 0
-	for {
-		idx := Index(src[start:], pathBase)
-		if idx == -1 {
-			c.WrString(BuffWork, src[start:])
+// longestPathMatchAt walks trie from src[pos:], returning every registered
+// base that matches starting exactly at pos, longest first. Two bases can
+// both match at the same position when one's base is a prefix of the
+// other's (e.g. "$HOME" -> "/home/user" and "$WORK" ->
+// "/home/user/work"); the caller tries each in order until one also
+// passes the component-boundary check.
+func longestPathMatchAt(trie *pathTrieNode, src string, pos int) []pathMatch {
+	var matches []pathMatch
+	node := trie
+	for i := pos; i < len(src); i++ {
+		node = node.children[src[i]]
+		if node == nil {
 			break
 		}
+		if node.terminal {
+			matches = append(matches, pathMatch{alias: node.alias, length: i - pos + 1, isRoot: node.isRoot})
+		}
+	}
+	for i, j := 0, len(matches)-1; i < j; i, j = i+1, j-1 {
+		matches[i], matches[j] = matches[j], matches[i]
+	}
+	return matches
+}
+
+// validPathMatch applies substituteBases' component-boundary rules to one
+// candidate match: component-aligned (the match's own leading separator
+// anchors it -- no mid-identifier match), not followed by another path
+// character, and the root-slash ("/" alone) special case.
+func validPathMatch(src string, start, endIdx int, isRoot bool) bool {
+	if isRoot {
+		valid := start == 0
+		if !valid {
+			prevChar := src[start-1]
+			valid = prevChar == ' ' || prevChar == '\t' || prevChar == '\n' || prevChar == '\r' || prevChar == '"' || prevChar == '\'' || prevChar == '('
+		}
+		// Root followed by another separator is not a valid single root match (e.g. //)
+		if valid && endIdx < len(src) && (src[endIdx] == '/' || src[endIdx] == '\\') {
+			valid = false
+		}
+		return valid
+	}
+	if endIdx == len(src) {
+		return true
+	}
+	nextChar := src[endIdx]
+	return nextChar == '/' || nextChar == '\\'
+}
 
-		matchIdx := start + idx
-		c.WrString(BuffWork, src[start:matchIdx])
+// substituteBases drives a single left-to-right pass over c's BuffOut
+// content, at each position trying trie for the longest registered base
+// matching there, and validating the same boundary rules the original
+// single-base scan used: component-aligned (the match's own leading
+// separator anchors it -- no mid-identifier match), not followed by
+// another path character, and the root-slash ("/" alone) special case.
+func substituteBases(c *Conv, trie *pathTrieNode) {
+	src := c.GetStringZeroCopy(BuffOut)
+	if src == "" {
+		return
+	}
 
-		// Validate match boundary
-		endIdx := matchIdx + len(pathBase)
-		isRoot := len(pathBase) == 1 && (pathBase[0] == '/' || pathBase[0] == '\\')
+	c.ResetBuffer(BuffWork)
 
-		valid := false
-		if isRoot {
-			// Root is valid if it's the start of a component
-			if matchIdx == 0 {
-				valid = true
-			} else {
-				prevChar := src[matchIdx-1]
-				if prevChar == ' ' || prevChar == '\t' || prevChar == '\n' || prevChar == '\r' || prevChar == '"' || prevChar == '\'' || prevChar == '(' {
-					valid = true
-				}
-			}
-			// Root followed by another separator is not a valid single root match (e.g. //)
-			if valid && endIdx < len(src) && (src[endIdx] == '/' || src[endIdx] == '\\') {
-				valid = false
+	start := 0
+	for start < len(src) {
+		matched := false
+		for _, m := range longestPathMatchAt(trie, src, start) {
+			endIdx := start + m.length
+			// A base that itself ends in a separator (a URL origin such as
+			// "https://example.com/app/") is component-aligned on its own.
+			baseEndsInSep := !m.isRoot && (src[endIdx-1] == '/' || src[endIdx-1] == '\\')
+			if !baseEndsInSep && !validPathMatch(src, start, endIdx, m.isRoot) {
+				continue
 			}
-		} else {
-			if endIdx == len(src) {
-				valid = true
-			} else {
-				nextChar := src[endIdx]
-				if nextChar == '/' || nextChar == '\\' {
-					valid = true
+			matched = true
+
+			if baseEndsInSep {
+				c.WrString(BuffWork, m.alias)
+				if endIdx < len(src) {
+					c.WrString(BuffWork, "/")
 				}
+				start = endIdx
+				break
 			}
-		}
 
-		if valid {
-			if isRoot {
+			if m.isRoot {
 				if endIdx == len(src) {
-					c.WrString(BuffWork, ".")
+					c.WrString(BuffWork, m.alias)
 				} else {
-					c.WrString(BuffWork, "./")
+					c.WrString(BuffWork, m.alias+"/")
 				}
 				start = endIdx
+				break
+			}
+
+			c.WrString(BuffWork, m.alias)
+			if endIdx < len(src) && (src[endIdx] == '/' || src[endIdx] == '\\') {
+				c.WrString(BuffWork, "/")
+				start = endIdx + 1
 			} else {
-				c.WrString(BuffWork, ".")
+				start = endIdx
+			}
+			break
+		}
+
+		if !matched {
+			c.WrString(BuffWork, src[start:start+1])
+			start++
+		}
+	}
+
+	c.swapBuff(BuffWork, BuffOut)
+}
+
+// aliasTrieNode is one node of the trie expandAliases walks, keyed on the
+// bytes of each registered alias token rather than its base.
+type aliasTrieNode struct {
+	children [256]*aliasTrieNode
+	base     string
+	terminal bool
+}
+
+// buildAliasTrie compiles entries into a trie keyed on each entry's
+// alias, the reverse direction of buildPathTrie, for PathLong's
+// alias-token-to-base expansion.
+func buildAliasTrie(entries []pathAliasEntry) *aliasTrieNode {
+	root := &aliasTrieNode{}
+	for _, e := range entries {
+		if e.alias == "" {
+			continue
+		}
+		node := root
+		for i := 0; i < len(e.alias); i++ {
+			ch := e.alias[i]
+			if node.children[ch] == nil {
+				node.children[ch] = &aliasTrieNode{}
+			}
+			node = node.children[ch]
+		}
+		if !node.terminal {
+			node.terminal = true
+			node.base = e.base
+		}
+	}
+	return root
+}
+
+func longestAliasMatchAt(trie *aliasTrieNode, src string, pos int) (base string, length int, ok bool) {
+	node := trie
+	for i := pos; i < len(src); i++ {
+		node = node.children[src[i]]
+		if node == nil {
+			break
+		}
+		if node.terminal {
+			base, length, ok = node.base, i-pos+1, true
+		}
+	}
+	return
+}
+
+// expandAliases drives PathLong's left-to-right pass over c's BuffOut
+// content, replacing a component-aligned alias token with its registered
+// base: the token must start the string or follow a separator/quote/
+// space, and must be followed by "/" or the end of the string.
+func expandAliases(c *Conv, trie *aliasTrieNode) {
+	src := c.GetStringZeroCopy(BuffOut)
+	if src == "" {
+		return
+	}
+
+	c.ResetBuffer(BuffWork)
 
-				// If followed by a separator, consume it and write "/" to normalize
+	start := 0
+	for start < len(src) {
+		base, length, ok := longestAliasMatchAt(trie, src, start)
+		if ok {
+			endIdx := start + length
+			boundaryBefore := start == 0
+			if !boundaryBefore {
+				prevChar := src[start-1]
+				boundaryBefore = prevChar == ' ' || prevChar == '\t' || prevChar == '\n' || prevChar == '\r' ||
+					prevChar == '"' || prevChar == '\'' || prevChar == '('
+			}
+			boundaryAfter := endIdx == len(src) || src[endIdx] == '/' || src[endIdx] == '\\'
+
+			if boundaryBefore && boundaryAfter {
+				c.WrString(BuffWork, base)
 				if endIdx < len(src) && (src[endIdx] == '/' || src[endIdx] == '\\') {
 					c.WrString(BuffWork, "/")
 					start = endIdx + 1
 				} else {
 					start = endIdx
 				}
+				continue
 			}
+		}
+
+		c.WrString(BuffWork, src[start:start+1])
+		start++
+	}
+
+	c.swapBuff(BuffWork, BuffOut)
+}
+
+// collapseArchiveMembers finds "<archive>.tar!/<inner>" and
+// "<archive>.zip!/<inner>" occurrences in c's BuffOut content and replaces
+// the inner path with just its base name, preserving the archive name:
+// "build.tar!/usr/lib/foo.so" -> "build.tar!/foo.so".
+func collapseArchiveMembers(c *Conv) {
+	src := c.GetStringZeroCopy(BuffOut)
+	if src == "" {
+		return
+	}
+
+	c.ResetBuffer(BuffWork)
+
+	start := 0
+	for {
+		tarIdx := indexArchiveMarker(src[start:], ".tar!/")
+		zipIdx := indexArchiveMarker(src[start:], ".zip!/")
+
+		markerLen := len(".tar!/")
+		idx := tarIdx
+		if idx == -1 || (zipIdx != -1 && zipIdx < idx) {
+			idx = zipIdx
+		}
+		if idx == -1 {
+			c.WrString(BuffWork, src[start:])
+			break
+		}
+
+		memberStart := start + idx + markerLen
+		c.WrString(BuffWork, src[start:memberStart])
+
+		end := memberStart
+		lastSep := -1
+		for end < len(src) {
+			ch := src[end]
+			if ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == '"' || ch == '\'' || ch == ')' {
+				break
+			}
+			if ch == '/' {
+				lastSep = end
+			}
+			end++
+		}
+
+		if lastSep == -1 {
+			c.WrString(BuffWork, src[memberStart:end])
 		} else {
-			// Not a valid path boundary, just copy the match and continue
-			c.WrString(BuffWork, pathBase)
-			start = endIdx
+			c.WrString(BuffWork, src[lastSep+1:end])
 		}
+		start = end
 	}
 
-	// Swap BuffWork to BuffOut
 	c.swapBuff(BuffWork, BuffOut)
+}
 
-	return c
+func indexArchiveMarker(s, marker string) int {
+	return Index(s, marker)
 }
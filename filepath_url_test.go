@@ -0,0 +1,92 @@
+package fmt
+
+import "testing"
+
+func TestPathShortURL(t *testing.T) {
+	originalBase := pathBase
+	originalURLBase := urlBase
+	defer func() {
+		pathBase = originalBase
+		urlBase = originalURLBase
+	}()
+	pathBase = ""
+
+	tests := []struct {
+		name string
+		base string
+		path string
+		want string
+	}{
+		{
+			name: "https origin",
+			base: "https://example.com/app/",
+			path: "GET https://example.com/app/modules/x.js 200",
+			want: "GET ./modules/x.js 200",
+		},
+		{
+			name: "file origin",
+			base: "file:///home/user/project/",
+			path: "loaded file:///home/user/project/web/client.go",
+			want: "loaded ./web/client.go",
+		},
+		{
+			name: "wasm origin same as base",
+			base: "wasm://app/",
+			path: "wasm://app/",
+			want: ".",
+		},
+		{
+			name: "different origin left untouched",
+			base: "https://example.com/app/",
+			path: "GET https://other.test/app/modules/x.js 200",
+			want: "GET https://other.test/app/modules/x.js 200",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			SetURLBase(tc.base)
+			got := Convert(tc.path).PathShort().String()
+			if got != tc.want {
+				t.Errorf("%s: PathShort(%q) with url base %q = %q; want %q", tc.name, tc.path, tc.base, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPathShortArchiveMember(t *testing.T) {
+	originalBase := pathBase
+	defer func() { pathBase = originalBase }()
+	pathBase = ""
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "tar member collapsed to base name",
+			path: "linking build.tar!/usr/lib/foo.so now",
+			want: "linking build.tar!/foo.so now",
+		},
+		{
+			name: "zip member collapsed to base name",
+			path: "reading assets.zip!/images/icons/logo.png",
+			want: "reading assets.zip!/logo.png",
+		},
+		{
+			name: "member with no nested dirs is unchanged",
+			path: "extracting build.tar!/main.go",
+			want: "extracting build.tar!/main.go",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Convert(tc.path).PathShort().String()
+			if got != tc.want {
+				t.Errorf("%s: PathShort(%q) = %q; want %q", tc.name, tc.path, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Package fmttest provides a golden-file test driver for composed
+// Translate/Convert operator chains, so regressions in whitespace, newline
+// or translation-fallback handling surface from a data table instead of
+// one-off hand-written test cases.
+package fmttest
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// Case is one golden-file entry: Inputs fed to Translate, the language to
+// translate into, and the Expected result after the test driver's operator
+// chain (Capitalize, in TestGoldenCases) is applied to Translate(Inputs...).
+type Case struct {
+	Name     string
+	Lang     string
+	Inputs   []string
+	Expected string
+}
+
+// LoadGoldenDir reads every *.txt file in dir and parses it into a Case.
+// Each file uses simple "key: value" lines:
+//
+//	name: simple multiline
+//	lang: en
+//	inputs: shortcuts|keyboard
+//	expected: Shortcuts Keyboard
+//
+// inputs is a "|"-separated list; a literal "|" in an input is not
+// supported, matching the simple word-list cases this harness targets.
+func LoadGoldenDir(dir string) ([]Case, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var cases []Case
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".txt") {
+			continue
+		}
+		c, err := loadGoldenFile(dir + "/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, c)
+	}
+	return cases, nil
+}
+
+func loadGoldenFile(path string) (Case, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Case{}, err
+	}
+	defer f.Close()
+
+	var c Case
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		switch key {
+		case "name":
+			c.Name = value
+		case "lang":
+			c.Lang = value
+		case "inputs":
+			c.Inputs = strings.Split(value, "|")
+		case "expected":
+			c.Expected = strings.ReplaceAll(value, `\n`, "\n")
+		}
+	}
+	return c, scanner.Err()
+}
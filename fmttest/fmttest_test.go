@@ -0,0 +1,79 @@
+package fmttest
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/tinywasm/fmt"
+)
+
+// TestGoldenCases runs every testdata/*.txt case. golden_multiline.txt
+// carries only the "Shortcuts Keyboard" words of the root package's
+// TestCapitalizeWithMultilineTranslation, not its newline runs: inputs are
+// not unescaped, so a case cannot feed a newline to Translate.
+func TestGoldenCases(t *testing.T) {
+	RegisterWords([]DictEntry{
+		{EN: "Shortcuts"},
+		{EN: "Keyboard"},
+	})
+
+	cases, err := LoadGoldenDir("testdata")
+	if err != nil {
+		t.Fatalf("LoadGoldenDir: %v", err)
+	}
+	if len(cases) == 0 {
+		t.Fatal("no golden cases found")
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			OutLang(tc.Lang)
+			args := make([]any, len(tc.Inputs))
+			for i, in := range tc.Inputs {
+				args[i] = in
+			}
+			got := Translate(args...).Capitalize().String()
+			if got != tc.Expected {
+				t.Errorf("got %q want %q", got, tc.Expected)
+			}
+		})
+	}
+}
+
+// FuzzTranslateChain feeds random argument pairs to Translate(args...),
+// followed by Capitalize, and asserts invariants that must hold for any
+// input: idempotent Capitalize, no panics on unregistered words, multiline
+// runs preserved, ANSI escapes passed through untouched.
+func FuzzTranslateChain(f *testing.F) {
+	f.Add("shortcuts keyboard", "\nTabs:\n")
+	f.Add("\x1b[31mred\x1b[0m", "")
+	f.Add("unregistered_word", "trailing \n\n")
+
+	f.Fuzz(func(t *testing.T, a, b string) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatalf("String() panicked on (%q, %q): %v", a, b, r)
+			}
+		}()
+
+		once := Translate(a, b).Capitalize().String()
+		twice := Translate(a, b).Capitalize().Capitalize().String()
+		if once != twice {
+			t.Errorf("Capitalize not idempotent: %q != %q", once, twice)
+		}
+
+		if strings.Contains(a+b, "\n\n") {
+			joined := Translate(a, b).String()
+			if !strings.Contains(joined, "\n\n") {
+				t.Errorf("multiline run not preserved in %q", joined)
+			}
+		}
+
+		if strings.Contains(a, "\x1b[") {
+			joined := Translate(a).String()
+			if !strings.Contains(joined, "\x1b[") {
+				t.Errorf("ANSI escape not preserved in %q", joined)
+			}
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package fmt
+
+import "testing"
+
+// FuzzConvertChain asserts algebraic invariants of the Convert chain that
+// must hold for any input, not just the hand-picked Unicode edge cases in
+// concurrency_test.go.
+func FuzzConvertChain(f *testing.F) {
+	for _, seed := range []string{
+		"Él Múrcielago Rápido",
+		"José María González",
+		"HELLO world",
+		"  trim   me  ",
+		"snake_case_word",
+		"camelCaseWord",
+		"",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		once := Convert(s).ToLower().String()
+		twice := Convert(once).ToLower().String()
+		if once != twice {
+			t.Errorf("ToLower not idempotent: %q -> %q -> %q", s, once, twice)
+		}
+	})
+}
+
+// FuzzTilde asserts Tilde never produces a byte above 0x7F -- it strips
+// diacritics down to plain ASCII.
+func FuzzTilde(f *testing.F) {
+	f.Add("Él Múrcielago Rápido")
+	f.Add("naïve café")
+	f.Add("plain ascii")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		out := Convert(s).Tilde().String()
+		for i := 0; i < len(out); i++ {
+			if out[i] > 0x7F {
+				t.Fatalf("Tilde(%q) produced non-ASCII byte 0x%x at %d: %q", s, out[i], i, out)
+			}
+		}
+	})
+}
+
+// FuzzSprintf asserts Sprintf("%s", s) == s for any input.
+func FuzzSprintf(f *testing.F) {
+	f.Add("hello")
+	f.Add("")
+	f.Add("100% done")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		got := Sprintf("%s", s)
+		if got != s {
+			t.Errorf("Sprintf(%%s, %q) = %q", s, got)
+		}
+	})
+}
+
+// FuzzCaseRoundTrip asserts CamelLow(SnakeLow(CamelLow(x))) == CamelLow(x)
+// for ASCII-letter inputs.
+func FuzzCaseRoundTrip(f *testing.F) {
+	f.Add("helloWorld")
+	f.Add("HelloWorld")
+	f.Add("already_snake")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		for _, r := range s {
+			if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+				t.Skip("non ASCII-letter input")
+			}
+		}
+
+		once := Convert(s).CamelLow().String()
+		roundTripped := Convert(Convert(once).SnakeLow().String()).CamelLow().String()
+		if once != roundTripped {
+			t.Errorf("CamelLow<->SnakeLow round trip: %q != %q", once, roundTripped)
+		}
+	})
+}
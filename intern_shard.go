@@ -0,0 +1,152 @@
+package fmt
+
+import "sync"
+
+// defaultIntern starts with 32 shards; newShardedIntern rounds any shard
+// count up to a power of two so shard selection can mask instead of mod.
+// 32 keeps per-shard contention low without the memory overhead of one
+// shard per goroutine on 500-goroutine workloads.
+var (
+	internMu       sync.Mutex // guards the two knobs below and defaultIntern swaps
+	internShardCap = 256
+	internDisabled bool
+	defaultIntern  = newShardedIntern(32, internShardCap)
+)
+
+// internShard is one bucket of the sharded cache: a small fixed-capacity
+// map guarded by its own mutex, with FIFO (CLOCK-less, simplest-correct)
+// eviction once it's full.
+type internShard struct {
+	mu       sync.Mutex
+	table    map[string]string
+	order    []string
+	capacity int
+}
+
+func newInternShard(capacity int) *internShard {
+	return &internShard{table: make(map[string]string, capacity), capacity: capacity}
+}
+
+func (s *internShard) get(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.table[key]
+	return v, ok
+}
+
+func (s *internShard) put(key string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := s.table[key]; ok {
+		return v
+	}
+
+	if s.capacity > 0 && len(s.order) >= s.capacity {
+		oldest := s.order[0]
+		s.order = s.order[1:]
+		delete(s.table, oldest)
+	}
+
+	s.table[key] = key
+	s.order = append(s.order, key)
+	return key
+}
+
+// shardedIntern is a sharded string-interning cache: shard selection is
+// fnv1a(key) & (len(shards)-1), each shard independently locked and
+// independently bounded.
+type shardedIntern struct {
+	shards []*internShard
+	mask   uint64
+}
+
+func newShardedIntern(shardCount, shardCapacity int) *shardedIntern {
+	if shardCount <= 0 {
+		shardCount = 1
+	}
+	// Round up to the next power of two.
+	n := 1
+	for n < shardCount {
+		n <<= 1
+	}
+
+	shards := make([]*internShard, n)
+	for i := range shards {
+		shards[i] = newInternShard(shardCapacity)
+	}
+	return &shardedIntern{shards: shards, mask: uint64(n - 1)}
+}
+
+func fnv1a(b []byte) uint64 {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+	h := uint64(offset)
+	for _, c := range b {
+		h ^= uint64(c)
+		h *= prime
+	}
+	return h
+}
+
+func (si *shardedIntern) internBytes(b []byte) string {
+	shard := si.shards[fnv1a(b)&si.mask]
+	key := string(b)
+	if v, ok := shard.get(key); ok {
+		return v
+	}
+	return shard.put(key)
+}
+
+// internStringFromBytes returns an interned copy of b's string conversion,
+// deduplicating identical strings across the process via a sharded cache so
+// concurrent callers (see TestConcurrentStringInterning) don't all serialize
+// on one mutex. DisableInterning turns this back into a plain conversion for
+// allocation-sensitive callers.
+func internStringFromBytes(b []byte) string {
+	internMu.Lock()
+	disabled := internDisabled
+	si := defaultIntern
+	internMu.Unlock()
+
+	if disabled {
+		return string(b)
+	}
+	return si.internBytes(b)
+}
+
+// SetInternShardCount rebuilds the interning cache with n shards (rounded
+// up to the next power of two), preserving the current per-shard capacity.
+// Existing interned entries are dropped.
+func SetInternShardCount(n int) {
+	internMu.Lock()
+	defer internMu.Unlock()
+	defaultIntern = newShardedIntern(n, internShardCap)
+}
+
+// SetInternShardCapacity rebuilds the interning cache with the same shard
+// count and a new per-shard capacity (0 means unbounded).
+func SetInternShardCapacity(n int) {
+	internMu.Lock()
+	defer internMu.Unlock()
+	internShardCap = n
+	defaultIntern = newShardedIntern(len(defaultIntern.shards), n)
+}
+
+// DisableInterning turns internStringFromBytes into a plain string
+// conversion, for callers that would rather pay one allocation per call
+// than hold any interning state.
+func DisableInterning() {
+	internMu.Lock()
+	internDisabled = true
+	internMu.Unlock()
+}
+
+// EnableInterning reverses DisableInterning.
+func EnableInterning() {
+	internMu.Lock()
+	internDisabled = false
+	internMu.Unlock()
+}
@@ -0,0 +1,100 @@
+package fmt
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestShardedInternDeduplicates(t *testing.T) {
+	si := newShardedIntern(8, 64)
+	a := si.internBytes([]byte("hello"))
+	b := si.internBytes([]byte("hello"))
+	if a != b {
+		t.Errorf("expected interned strings to be equal, got %q vs %q", a, b)
+	}
+}
+
+func TestInternShardCapacityEviction(t *testing.T) {
+	si := newShardedIntern(1, 2)
+	si.internBytes([]byte("a"))
+	si.internBytes([]byte("b"))
+	si.internBytes([]byte("c")) // evicts "a"
+
+	shard := si.shards[0]
+	if _, ok := shard.get("a"); ok {
+		t.Error("expected \"a\" to have been evicted")
+	}
+	if _, ok := shard.get("c"); !ok {
+		t.Error("expected \"c\" to be present")
+	}
+}
+
+// singleLockIntern is the naive baseline the sharded cache is benchmarked
+// against: one map behind one mutex.
+type singleLockIntern struct {
+	mu    sync.Mutex
+	table map[string]string
+}
+
+func (s *singleLockIntern) internBytes(b []byte) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := string(b)
+	if v, ok := s.table[key]; ok {
+		return v
+	}
+	s.table[key] = key
+	return key
+}
+
+func BenchmarkIntern(b *testing.B) {
+	for _, goroutines := range []int{1, 8, 64, 500} {
+		b.Run("SingleLock/"+strconv.Itoa(goroutines), func(b *testing.B) {
+			si := &singleLockIntern{table: map[string]string{}}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var wg sync.WaitGroup
+				wg.Add(goroutines)
+				for g := 0; g < goroutines; g++ {
+					go func(id int) {
+						defer wg.Done()
+						si.internBytes([]byte("key-" + strconv.Itoa(id%10)))
+					}(g)
+				}
+				wg.Wait()
+			}
+		})
+
+		b.Run("Sharded/"+strconv.Itoa(goroutines), func(b *testing.B) {
+			si := newShardedIntern(32, 256)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var wg sync.WaitGroup
+				wg.Add(goroutines)
+				for g := 0; g < goroutines; g++ {
+					go func(id int) {
+						defer wg.Done()
+						si.internBytes([]byte("key-" + strconv.Itoa(id%10)))
+					}(g)
+				}
+				wg.Wait()
+			}
+		})
+	}
+}
+
+func TestSprintfAllocBudget(t *testing.T) {
+	allocs := testing.AllocsPerRun(1000, func() {
+		_ = Sprintf("Hello %s, you have %d messages", "World", 5)
+	})
+	// Documents a loose budget for this two-arg format; Sprintf already tries
+	// sprintfFast's pooled buffer first, and allocs_test.go's Budget pins the
+	// tighter per-format counts.
+	const budget = 10
+	if allocs > budget {
+		t.Errorf("Sprintf allocs/run = %.1f, want <= %d", allocs, budget)
+	}
+}
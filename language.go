@@ -1,5 +1,7 @@
 package fmt
 
+import "strings"
+
 // Private global configuration with mutex protection
 var (
 	defLang lang = EN
@@ -93,8 +95,14 @@ type LocStr [9]string
 // OutLang("ES")            // Set Spanish as default (using string code), returns "ES"
 // OutLang("fr")            // Set French as default (case-insensitive), returns "FR"
 // OutLang("en-US")         // Accepts locale strings, parses to EN, returns "EN"
+// OutLang(tag)             // Accepts a Tag parsed via ParseTag, e.g. ParseTag("zh-Hant-TW")
+// OutLang("fr;q=0.9, en;q=0.8") // Accepts a weighted Accept-Language header, resolved via MatchLang
 //
-// If a string is passed, it is automatically parsed using supported codes.
+// If a string is passed, it is automatically parsed using supported codes,
+// unless it contains a "," or ";" -- a weighted Accept-Language header or a
+// "$LANG"-style list -- in which case it is resolved via MatchLang instead,
+// honoring each tag's q value and falling back through regional neighbors.
+// If a Tag is passed, its Lang subtag is resolved the same way.
 // If a lang value is passed, it is assigned directly.
 // If another type is passed, nothing happens.
 // Always returns the current language code as string (e.g. "EN", "ES", etc).
@@ -110,8 +118,14 @@ func OutLang(l ...any) string {
 	switch v := l[0].(type) {
 	case lang:
 		newLang = v
+	case Tag:
+		newLang = tagToLang(v)
 	case string:
-		newLang = c.langParser(v)
+		if strings.ContainsAny(v, ",;") {
+			newLang = MatchLang(v)
+		} else {
+			newLang = c.langParser(v)
+		}
 	default:
 		// Return current language without changes
 		return getCurrentLang().String()
@@ -0,0 +1,112 @@
+package fmt
+
+import (
+	"sort"
+	"strings"
+)
+
+// neighborLang is a curated regional/script fallback table for languages
+// with no exact match among the 9 supported langs: the nearest supported
+// language a speaker of the key's language is also likely to read. Keyed
+// by lowercase ISO 639 code, the same normalization ParseTag applies.
+var neighborLang = map[string]lang{
+	"ca": ES, // Catalan
+	"gl": ES, // Galician
+	"uk": RU, // Ukrainian
+	"be": RU, // Belarusian
+	"ur": HI, // Urdu (shares spoken Hindustani with Hindi)
+	"nl": DE, // Dutch
+	"af": DE, // Afrikaans (closest Germanic of the 9 supported)
+}
+
+// baseLangCodes maps a lowercase ISO 639 code to its lang constant for the
+// 9 supported languages -- MatchLang/MatchLangs' exact-match step. A bare
+// code match also covers script/region variants of the same language
+// (pt-BR, zh-Hant) since lang does not itself track script or region.
+var baseLangCodes = map[string]lang{
+	"en": EN, "es": ES, "zh": ZH, "hi": HI, "ar": AR,
+	"pt": PT, "fr": FR, "de": DE, "ru": RU,
+}
+
+// langForTag resolves t to one of the 9 supported langs, trying an exact
+// base-code match first (covering script/region variants of the same
+// language, e.g. pt-BR, zh-Hant), then the curated neighbor table.
+// Returns ok=false if neither matches.
+func langForTag(t Tag) (lang, bool) {
+	if l, ok := baseLangCodes[t.Lang]; ok {
+		return l, true
+	}
+	if l, ok := neighborLang[t.Lang]; ok {
+		return l, true
+	}
+	return EN, false
+}
+
+// acceptCandidate is one "tag;q=weight" entry MatchLangs scores, mirroring
+// acceptEntry's grammar but keyed by the raw tag string so "*" survives
+// (ParseTag would reject it).
+type acceptCandidate struct {
+	code string
+	q    float64
+}
+
+// parseAcceptCandidate splits "tag;q=weight" into its code and q (default
+// 1.0) via parseWeightedEntry -- language_match.go's parseAcceptLanguage
+// shares the same underlying grammar -- keeping code as the raw string
+// (unlike parseAcceptLanguage's acceptEntry) so "*" survives for MatchLangs
+// to special-case; ParseTag would otherwise reject it.
+func parseAcceptCandidate(raw string) (acceptCandidate, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return acceptCandidate{}, false
+	}
+
+	code, q := parseWeightedEntry(raw)
+	if q <= 0 {
+		return acceptCandidate{}, false
+	}
+	return acceptCandidate{code: code, q: q}, true
+}
+
+// MatchLangs scores candidates -- each a bare tag, a "tag;q=weight" pair
+// (the Accept-Language grammar), or "*" -- by descending q (ties keep
+// candidate order), and returns the first one that resolves to a
+// supported lang via langForTag. "*" matches the current default language
+// (see OutLang). Returns the current default if nothing matches.
+func MatchLangs(candidates ...string) lang {
+	entries := make([]acceptCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		if entry, ok := parseAcceptCandidate(c); ok {
+			entries = append(entries, entry)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].q > entries[j].q })
+
+	for _, e := range entries {
+		if e.code == "*" {
+			return getCurrentLang()
+		}
+		tag, err := ParseTag(e.code)
+		if err != nil {
+			continue
+		}
+		if l, ok := langForTag(tag); ok {
+			return l
+		}
+	}
+	return getCurrentLang()
+}
+
+// MatchLang parses accept -- a full HTTP Accept-Language header value
+// (e.g. "fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5") or a "$LANG"-style
+// colon-separated list (e.g. "en_US:fr:de") -- and returns the best
+// supported language via MatchLangs, so browser/CLI code hitting a WASM
+// binary can pick the best available language in one call instead of
+// mutating OutLang's process-global default ahead of time.
+func MatchLang(accept string) lang {
+	sep := ","
+	if !strings.Contains(accept, ",") && strings.Contains(accept, ":") {
+		sep = ":"
+	}
+	return MatchLangs(strings.Split(accept, sep)...)
+}
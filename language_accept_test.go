@@ -0,0 +1,81 @@
+package fmt
+
+import "testing"
+
+func TestMatchLangsExactMatch(t *testing.T) {
+	if got := MatchLangs("fr"); got != FR {
+		t.Errorf("MatchLangs(fr) = %v, want FR", got)
+	}
+}
+
+func TestMatchLangsMacroFallback(t *testing.T) {
+	if got := MatchLangs("pt-BR"); got != PT {
+		t.Errorf("MatchLangs(pt-BR) = %v, want PT", got)
+	}
+	if got := MatchLangs("zh-Hant"); got != ZH {
+		t.Errorf("MatchLangs(zh-Hant) = %v, want ZH", got)
+	}
+}
+
+func TestMatchLangsNeighborFallback(t *testing.T) {
+	tests := []struct {
+		code string
+		want lang
+	}{
+		{"ca", ES},
+		{"uk", RU},
+		{"ur", HI},
+		{"nl", DE},
+	}
+	for _, tt := range tests {
+		if got := MatchLangs(tt.code); got != tt.want {
+			t.Errorf("MatchLangs(%q) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestMatchLangsWildcardMatchesDefault(t *testing.T) {
+	OutLang(ES)
+	defer OutLang(EN)
+
+	if got := MatchLangs("*"); got != ES {
+		t.Errorf("MatchLangs(*) = %v, want current default ES", got)
+	}
+}
+
+func TestMatchLangsOrdersByWeight(t *testing.T) {
+	got := MatchLangs("de;q=0.7", "fr-CH", "fr;q=0.9", "en;q=0.8")
+	if got != FR {
+		t.Errorf("MatchLangs weighted = %v, want FR (fr-CH has implicit q=1.0)", got)
+	}
+}
+
+func TestMatchLangsSkipsUnresolvedFallsBackToDefault(t *testing.T) {
+	OutLang(EN)
+	if got := MatchLangs("xx-YY"); got != EN {
+		t.Errorf("MatchLangs(xx-YY) = %v, want default EN", got)
+	}
+}
+
+func TestMatchLangHeaderStyle(t *testing.T) {
+	got := MatchLang("fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5")
+	if got != FR {
+		t.Errorf("MatchLang(header) = %v, want FR", got)
+	}
+}
+
+func TestMatchLangColonSeparatedStyle(t *testing.T) {
+	got := MatchLang("uk:de:en")
+	if got != RU {
+		t.Errorf("MatchLang(uk:de:en) = %v, want RU via neighbor fallback", got)
+	}
+}
+
+func TestOutLangAcceptsAcceptLanguageHeader(t *testing.T) {
+	defer OutLang(EN)
+
+	got := OutLang("de;q=0.7, fr;q=0.9, en;q=0.8")
+	if got != "FR" {
+		t.Errorf("OutLang(header) = %q, want %q", got, "FR")
+	}
+}
@@ -0,0 +1,121 @@
+package fmt
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	displayNamesMu sync.RWMutex
+	// displayNames[canonDisplayTag(named)][canonDisplayTag(in)] = name, e.g.
+	// displayNames["fr"]["es"] == "Francés".
+	displayNames = map[string]map[string]string{}
+)
+
+func init() {
+	RegisterDisplayNames(map[string]map[string]string{
+		"en":      {"en": "English", "es": "Inglés", "fr": "Anglais"},
+		"es":      {"en": "Spanish", "es": "Español", "fr": "Espagnol"},
+		"zh":      {"en": "Chinese", "es": "Chino", "fr": "Chinois"},
+		"zh-Hant": {"en": "Traditional Chinese", "es": "Chino tradicional", "fr": "Chinois traditionnel"},
+		"hi":      {"en": "Hindi", "es": "Hindi", "fr": "Hindi"},
+		"ar":      {"en": "Arabic", "es": "Árabe", "fr": "Arabe"},
+		"pt":      {"en": "Portuguese", "es": "Portugués", "fr": "Portugais"},
+		"fr":      {"en": "French", "es": "Francés", "fr": "Français"},
+		"de":      {"en": "German", "es": "Alemán", "fr": "Allemand"},
+		"ru":      {"en": "Russian", "es": "Ruso", "fr": "Russe"},
+	})
+}
+
+// canonDisplayTag reduces t to the subtags that change a language's
+// display name -- its language and, when present, script (e.g. "zh-Hant")
+// -- since the region subtag ("zh-Hant-TW") doesn't get its own name.
+func canonDisplayTag(t Tag) string {
+	key := strings.ToLower(t.Lang)
+	if t.Script != "" {
+		key += "-" + t.Script
+	}
+	return key
+}
+
+// RegisterDisplayNames registers (or merges) the human-readable name of one
+// or more language tags, keyed first by the tag being named then by the
+// tag the name is rendered in (e.g. names["fr"]["es"] = "Francés"), the
+// same way RegisterRegionalWords keys its overrides by tag string instead
+// of growing a struct field per locale. Invalid tag strings are ignored.
+func RegisterDisplayNames(names map[string]map[string]string) {
+	displayNamesMu.Lock()
+	defer displayNamesMu.Unlock()
+
+	for namedStr, byIn := range names {
+		named, err := ParseTag(namedStr)
+		if err != nil {
+			continue
+		}
+		key := canonDisplayTag(named)
+		existing, ok := displayNames[key]
+		if !ok {
+			existing = map[string]string{}
+			displayNames[key] = existing
+		}
+		for inStr, name := range byIn {
+			in, err := ParseTag(inStr)
+			if err != nil {
+				continue
+			}
+			existing[canonDisplayTag(in)] = name
+		}
+	}
+}
+
+// DisplayName returns tag's human-readable language name as rendered in
+// in's language, e.g. DisplayName(french, spanish) == "Francés", falling
+// back through: in's language -> English -> tag's own canonical string
+// (e.g. "zh-Hant") when no name is registered, rather than returning "".
+func DisplayName(tag Tag, in Tag) string {
+	displayNamesMu.RLock()
+	defer displayNamesMu.RUnlock()
+
+	byIn, ok := displayNames[canonDisplayTag(tag)]
+	if !ok && tag.Script != "" {
+		byIn, ok = displayNames[strings.ToLower(tag.Lang)]
+	}
+	if !ok {
+		return tag.String()
+	}
+
+	if name, ok := byIn[canonDisplayTag(in)]; ok {
+		return name
+	}
+	if name, ok := byIn["en"]; ok {
+		return name
+	}
+	return tag.String()
+}
+
+// RenderDisplayNameVerb expands arg (a Tag, a lang constant, or a BCP 47
+// tag string) to its display name in the current output language (see
+// OutLang), via the same fallback chain DisplayName documents. An
+// unparseable string argument is returned unchanged rather than dropped.
+// No format-string verb (such as %N in Html/Translate) calls it; callers
+// invoke it directly.
+func RenderDisplayNameVerb(arg any) string {
+	var tag Tag
+	switch v := arg.(type) {
+	case Tag:
+		tag = v
+	case lang:
+		tag = Tag{Lang: strings.ToLower(v.String())}
+	case string:
+		parsed, err := ParseTag(v)
+		if err != nil {
+			return v
+		}
+		tag = parsed
+	default:
+		return ""
+	}
+
+	in := Tag{Lang: strings.ToLower(getCurrentLang().String())}
+	return DisplayName(tag, in)
+}
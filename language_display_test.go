@@ -0,0 +1,69 @@
+package fmt
+
+import "testing"
+
+func TestDisplayName(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  Tag
+		in   Tag
+		want string
+	}{
+		{"french in spanish", mustParseTag(t, "fr"), mustParseTag(t, "es"), "Francés"},
+		{"spanish in english", mustParseTag(t, "es"), mustParseTag(t, "en"), "Spanish"},
+		{"traditional chinese in english", mustParseTag(t, "zh-Hant"), mustParseTag(t, "en"), "Traditional Chinese"},
+		{"region subtag ignored", mustParseTag(t, "zh-Hant-TW"), mustParseTag(t, "en"), "Traditional Chinese"},
+		{"unregistered language falls back to english", mustParseTag(t, "it"), mustParseTag(t, "es"), "Italian"},
+		{"unregistered everywhere falls back to tag string", mustParseTag(t, "yue"), mustParseTag(t, "es"), "yue"},
+	}
+
+	RegisterDisplayNames(map[string]map[string]string{
+		"it": {"en": "Italian"},
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DisplayName(tt.tag, tt.in)
+			if got != tt.want {
+				t.Errorf("DisplayName(%+v, %+v) = %q, want %q", tt.tag, tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderDisplayNameVerb(t *testing.T) {
+	OutLang(EN)
+	defer OutLang(EN)
+
+	tests := []struct {
+		name string
+		lang string
+		arg  any
+		want string
+	}{
+		{"tag arg in EN", "en", mustParseTag(t, "es"), "Spanish"},
+		{"tag-string arg in EN", "en", "fr", "French"},
+		{"tag arg in ES", "es", mustParseTag(t, "fr"), "Francés"},
+		{"tag-string arg in ES", "es", "zh-Hant", "Chino tradicional"},
+		{"unparseable string arg returned unchanged", "en", "not a tag", "not a tag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			OutLang(tt.lang)
+			got := RenderDisplayNameVerb(tt.arg)
+			if got != tt.want {
+				t.Errorf("RenderDisplayNameVerb(%v) in %s: got %q want %q", tt.arg, tt.lang, got, tt.want)
+			}
+		})
+	}
+}
+
+func mustParseTag(t *testing.T, s string) Tag {
+	t.Helper()
+	tag, err := ParseTag(s)
+	if err != nil {
+		t.Fatalf("ParseTag(%q): %v", s, err)
+	}
+	return tag
+}
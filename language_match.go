@@ -0,0 +1,248 @@
+package fmt
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Confidence ranks how well a supported Tag satisfies a desired Tag,
+// from no match at all up to an exact one.
+type Confidence int
+
+const (
+	No Confidence = iota
+	Low
+	Medium
+	High
+)
+
+func (c Confidence) String() string {
+	switch c {
+	case No:
+		return "No"
+	case Low:
+		return "Low"
+	case Medium:
+		return "Medium"
+	case High:
+		return "High"
+	default:
+		return "No"
+	}
+}
+
+// Matcher picks the best of a fixed set of supported languages for a list
+// of desired ones, e.g. the tags an HTTP Accept-Language header names.
+// Build one with NewMatcher and reuse it across requests.
+type Matcher struct {
+	supported []Tag
+}
+
+// NewMatcher builds a Matcher over supported, in preference order: when
+// two supported tags tie a desired tag's confidence, the one earlier in
+// supported wins.
+func NewMatcher(supported ...Tag) *Matcher {
+	return &Matcher{supported: supported}
+}
+
+// Match scores every (desired, supported) pair and returns the
+// highest-confidence supported tag, its index in m.supported, and the
+// Confidence achieved. Ties break on desired order (an earlier desired
+// tag's match is preferred over a later one's of equal confidence), then
+// on supported order. If nothing in supported matches any desired tag at
+// all, Match returns the zero Tag, index -1 and No.
+func (m *Matcher) Match(desired ...Tag) (Tag, int, Confidence) {
+	ptDefault := m.ptDefaultRegion()
+
+	best := Tag{}
+	bestIdx := -1
+	bestConf := No
+
+	for _, d := range desired {
+		for si, s := range m.supported {
+			conf := matchConfidence(d, s, ptDefault)
+			if conf > bestConf {
+				bestConf = conf
+				best = s
+				bestIdx = si
+			}
+		}
+	}
+	return best, bestIdx, bestConf
+}
+
+// ptDefaultRegion implements "pt defaults to pt-BR unless pt-PT is
+// present": a bare "pt" desired tag prefers whichever of the two this
+// Matcher's supported set actually offers, favoring pt-PT when both are
+// supported.
+func (m *Matcher) ptDefaultRegion() string {
+	hasBR, hasPT := false, false
+	for _, s := range m.supported {
+		if s.Lang != "pt" {
+			continue
+		}
+		switch s.Region {
+		case "BR":
+			hasBR = true
+		case "PT":
+			hasPT = true
+		}
+	}
+	if hasPT {
+		return "PT"
+	}
+	if hasBR {
+		return "BR"
+	}
+	return ""
+}
+
+// matchConfidence scores supported tag s against desired tag d. Exact
+// matches are High; same language and the same non-empty script with a
+// different region are Medium (two script-less tags that differ only in
+// region, e.g. en-US and en-GB, are Low); a known macro-language/script
+// equivalence (zh-Hant<->zh-TW,
+// es-419<->es-MX/es-AR/..., bare "pt"<->ptDefault) is also Medium; any
+// other shared language is Low; a different language is No.
+func matchConfidence(d, s Tag, ptDefault string) Confidence {
+	if d.Lang != s.Lang {
+		return No
+	}
+	if d.Region == s.Region && d.Script == s.Script {
+		return High
+	}
+	if d.Script != "" && d.Script == s.Script {
+		return Medium
+	}
+	if macroEquivalent(d, s, ptDefault) {
+		return Medium
+	}
+	return Low
+}
+
+// macroEquivalent implements the hard-coded macro-language/script table:
+// zh-Hant and zh-TW denote the same Traditional Chinese convention,
+// es-419 (Latin America and the Caribbean) covers the regions regionMacro
+// maps to it (es-MX, es-AR, ...), and a bare "pt" resolves to ptDefault
+// (pt-BR unless pt-PT is supported).
+func macroEquivalent(d, s Tag, ptDefault string) bool {
+	switch d.Lang {
+	case "zh":
+		if (d.Script == "Hant" && s.Region == "TW") || (s.Script == "Hant" && d.Region == "TW") {
+			return true
+		}
+	case "es":
+		dIs419 := d.Region == "419" || regionMacro[d.Region] == "419"
+		sIs419 := s.Region == "419" || regionMacro[s.Region] == "419"
+		if dIs419 && sIs419 {
+			return true
+		}
+	case "pt":
+		if d.Region == "" && ptDefault != "" && s.Region == ptDefault {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptEntry is one "tag;q=weight" item from an Accept-Language header.
+type acceptEntry struct {
+	tag Tag
+	q   float64
+}
+
+// parseWeightedEntry splits one comma-separated Accept-Language item --
+// "tag;q=weight", or a bare tag defaulting to q=1.0 -- into its code and
+// q. It does not reject "*" or validate code as a tag; parseAcceptLanguage
+// and language_accept.go's parseAcceptCandidate each apply those checks
+// themselves, since they disagree on whether "*" is a valid candidate.
+// This is the one place the q-weight grammar itself is defined.
+func parseWeightedEntry(raw string) (code string, q float64) {
+	code, q = raw, 1.0
+	i := strings.Index(raw, ";")
+	if i == -1 {
+		return code, q
+	}
+	code = strings.TrimSpace(raw[:i])
+	qPart := strings.TrimSpace(raw[i+1:])
+	if strings.HasPrefix(qPart, "q=") {
+		if parsed, err := strconv.ParseFloat(strings.TrimPrefix(qPart, "q="), 64); err == nil {
+			q = parsed
+		}
+	}
+	return code, q
+}
+
+// parseAcceptLanguage parses an Accept-Language header value into
+// (tag, q) pairs, dropping q=0 entries and unparseable tags, and sorts
+// the result stable-descending by q (ties keep header order).
+func parseAcceptLanguage(header string) []acceptEntry {
+	var entries []acceptEntry
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		tagStr, q := parseWeightedEntry(part)
+		if tagStr == "*" || q <= 0 {
+			continue
+		}
+
+		tag, err := ParseTag(tagStr)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, acceptEntry{tag: tag, q: q})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].q > entries[j].q })
+	return entries
+}
+
+// MatchAcceptLanguage parses header (an HTTP Accept-Language value) and
+// returns the best tag among supported, so server code can call
+// OutLang(fmt.MatchAcceptLanguage(r.Header.Get("Accept-Language"), supported...))
+// once per request instead of mutating process-global state ahead of time.
+// Returns the zero Tag if header has no usable entries or none match.
+func MatchAcceptLanguage(header string, supported ...Tag) Tag {
+	entries := parseAcceptLanguage(header)
+	if len(entries) == 0 {
+		return Tag{}
+	}
+
+	desired := make([]Tag, len(entries))
+	for i, e := range entries {
+		desired[i] = e.tag
+	}
+
+	best, _, conf := NewMatcher(supported...).Match(desired...)
+	if conf == No {
+		return Tag{}
+	}
+	return best
+}
+
+// TranslateWithLang behaves like TranslateTag, but takes an already
+// parsed Tag (e.g. the result of MatchAcceptLanguage) instead of a raw
+// string, so a request handler can resolve args in the caller's language
+// without mutating the process-global OutLang default other concurrent
+// requests rely on.
+func TranslateWithLang(tag Tag, args ...any) *Conv {
+	resolved := make([]any, len(args))
+	for i, a := range args {
+		if key, ok := a.(string); ok {
+			if word, ok := lookupRegionalWord(tag, key); ok {
+				resolved[i] = word
+				continue
+			}
+		}
+		resolved[i] = a
+	}
+
+	full := make([]any, 0, len(resolved)+1)
+	full = append(full, tagToLang(tag))
+	full = append(full, resolved...)
+	return Translate(full...)
+}
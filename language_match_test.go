@@ -0,0 +1,117 @@
+package fmt
+
+import "testing"
+
+func mustTag(t *testing.T, s string) Tag {
+	t.Helper()
+	tag, err := ParseTag(s)
+	if err != nil {
+		t.Fatalf("ParseTag(%q): %v", s, err)
+	}
+	return tag
+}
+
+func TestMatcherExactMatch(t *testing.T) {
+	m := NewMatcher(mustTag(t, "en-US"), mustTag(t, "es-MX"))
+	got, idx, conf := m.Match(mustTag(t, "es-MX"))
+	if conf != High || idx != 1 || got.String() != "es-MX" {
+		t.Errorf("got %v idx=%d conf=%v", got, idx, conf)
+	}
+}
+
+func TestMatcherSameLangDifferentRegion(t *testing.T) {
+	m := NewMatcher(mustTag(t, "en-GB"))
+	got, _, conf := m.Match(mustTag(t, "en-US"))
+	if conf != Low || got.String() != "en-GB" {
+		t.Errorf("got %v conf=%v, want en-GB Low", got, conf)
+	}
+}
+
+func TestMatcherZhHantMacro(t *testing.T) {
+	m := NewMatcher(mustTag(t, "zh-TW"))
+	got, _, conf := m.Match(mustTag(t, "zh-Hant"))
+	if conf != Medium || got.String() != "zh-TW" {
+		t.Errorf("got %v conf=%v, want zh-TW Medium", got, conf)
+	}
+}
+
+func TestMatcherEs419Macro(t *testing.T) {
+	m := NewMatcher(mustTag(t, "es-419"))
+	got, _, conf := m.Match(mustTag(t, "es-MX"))
+	if conf != Medium || got.String() != "es-419" {
+		t.Errorf("got %v conf=%v, want es-419 Medium", got, conf)
+	}
+}
+
+func TestMatcherPtDefaultsToBR(t *testing.T) {
+	m := NewMatcher(mustTag(t, "pt-BR"), mustTag(t, "en"))
+	got, _, conf := m.Match(mustTag(t, "pt"))
+	if conf != Medium || got.String() != "pt-BR" {
+		t.Errorf("got %v conf=%v, want pt-BR Medium", got, conf)
+	}
+}
+
+func TestMatcherPtPrefersPTWhenPresent(t *testing.T) {
+	m := NewMatcher(mustTag(t, "pt-BR"), mustTag(t, "pt-PT"))
+	got, _, conf := m.Match(mustTag(t, "pt"))
+	if conf != Medium || got.String() != "pt-PT" {
+		t.Errorf("got %v conf=%v, want pt-PT Medium", got, conf)
+	}
+}
+
+func TestMatcherNoMatch(t *testing.T) {
+	m := NewMatcher(mustTag(t, "en-US"))
+	got, idx, conf := m.Match(mustTag(t, "ja"))
+	if conf != No || idx != -1 || got.String() != "" {
+		t.Errorf("got %v idx=%d conf=%v, want No match", got, idx, conf)
+	}
+}
+
+func TestMatcherDesiredOrderTieBreak(t *testing.T) {
+	m := NewMatcher(mustTag(t, "fr"), mustTag(t, "de"))
+	got, _, conf := m.Match(mustTag(t, "fr-CA"), mustTag(t, "de-DE"))
+	if conf != Low || got.String() != "fr" {
+		t.Errorf("got %v conf=%v, want fr (earlier desired tag wins tie)", got, conf)
+	}
+}
+
+func TestMatchAcceptLanguage(t *testing.T) {
+	supported := []Tag{mustTag(t, "en"), mustTag(t, "es-MX"), mustTag(t, "fr")}
+
+	got := MatchAcceptLanguage("fr-CH;q=0.5, es-MX;q=0.9, en;q=0.8", supported...)
+	if got.String() != "es-MX" {
+		t.Errorf("got %v, want es-MX (highest q exact match)", got)
+	}
+}
+
+func TestMatchAcceptLanguageDropsZeroQ(t *testing.T) {
+	supported := []Tag{mustTag(t, "en"), mustTag(t, "fr")}
+
+	got := MatchAcceptLanguage("fr;q=0, en;q=0.5", supported...)
+	if got.String() != "en" {
+		t.Errorf("got %v, want en (fr excluded by q=0)", got)
+	}
+}
+
+func TestMatchAcceptLanguageNoMatch(t *testing.T) {
+	supported := []Tag{mustTag(t, "en")}
+
+	got := MatchAcceptLanguage("ja, ko", supported...)
+	if got.String() != "" {
+		t.Errorf("got %v, want zero Tag", got)
+	}
+}
+
+func TestTranslateWithLang(t *testing.T) {
+	RegisterWords([]DictEntry{
+		{EN: "withlang_hi", ES: "withlang_hola"},
+	})
+	if err := RegisterRegionalWords("es-MX", map[string]string{"withlang_hi": "withlang_quihubo"}); err != nil {
+		t.Fatalf("RegisterRegionalWords: %v", err)
+	}
+
+	got := TranslateWithLang(mustTag(t, "es-MX"), "withlang_hi").String()
+	if got != "withlang_quihubo" {
+		t.Errorf("got %q want %q", got, "withlang_quihubo")
+	}
+}
@@ -0,0 +1,232 @@
+package fmt
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Tag is a canonicalized BCP 47 language tag: a required language subtag
+// plus the optional script, region and variant subtags. ParseTag builds
+// one from a raw string like "en-US", "zh-Hant-TW" or "pt_BR"; Translate
+// and OutLang accept a Tag anywhere they accept a lang constant or a plain
+// "EN"/"es" string.
+type Tag struct {
+	Lang     string // canonical lowercase ISO 639 code, e.g. "en", "zh"
+	Script   string // canonical title-case ISO 15924 code, e.g. "Hant" ("" if absent)
+	Region   string // canonical upper-case ISO 3166-1 or UN M49 code, e.g. "US", "419" ("" if absent)
+	Variants []string
+}
+
+// String renders t back to its canonical hyphenated form, e.g.
+// Tag{Lang: "zh", Script: "Hant", Region: "TW"}.String() == "zh-Hant-TW".
+func (t Tag) String() string {
+	parts := []string{t.Lang}
+	if t.Script != "" {
+		parts = append(parts, t.Script)
+	}
+	if t.Region != "" {
+		parts = append(parts, t.Region)
+	}
+	parts = append(parts, t.Variants...)
+	return strings.Join(parts, "-")
+}
+
+// ParseTag parses a BCP 47 language tag, tokenizing on "-" or "_". Every
+// subtag must be 1-8 alphanumeric characters; empty subtags ("en-US-",
+// "en--US") and non-alphanumeric characters ("bad/") are rejected, as are
+// subtags over 8 chars ("morethan8"). The first subtag is a 2- or 3-letter
+// language; it may be followed by a 4-letter script (canonicalized to
+// title-case, e.g. "Latn") and/or a 2-letter or 3-digit region
+// (canonicalized to upper-case); anything left over is kept as variants.
+func ParseTag(s string) (Tag, error) {
+	subtags := strings.FieldsFunc(s, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 0 || strings.ContainsAny(s, " \t") {
+		return Tag{}, Errf("fmt: invalid language tag %q", s)
+	}
+	if countSeparators(s) != len(subtags)-1 {
+		return Tag{}, Errf("fmt: invalid language tag %q", s)
+	}
+
+	for _, sub := range subtags {
+		if len(sub) == 0 || len(sub) > 8 || !isAlphanumeric(sub) {
+			return Tag{}, Errf("fmt: invalid subtag %q in language tag %q", sub, s)
+		}
+	}
+
+	if !isAlpha(subtags[0]) || len(subtags[0]) < 2 || len(subtags[0]) > 3 {
+		return Tag{}, Errf("fmt: invalid language subtag %q", subtags[0])
+	}
+
+	t := Tag{Lang: strings.ToLower(subtags[0])}
+	rest := subtags[1:]
+
+	if len(rest) > 0 && len(rest[0]) == 4 && isAlpha(rest[0]) {
+		t.Script = titleCase(rest[0])
+		rest = rest[1:]
+	}
+
+	if len(rest) > 0 && isRegionSubtag(rest[0]) {
+		t.Region = strings.ToUpper(rest[0])
+		rest = rest[1:]
+	}
+
+	t.Variants = rest
+	return t, nil
+}
+
+// countSeparators reports how many "-"/"_" characters appear in s, used by
+// ParseTag to detect adjacent separators (e.g. "en--US") that
+// strings.FieldsFunc would otherwise silently collapse.
+func countSeparators(s string) int {
+	n := 0
+	for _, r := range s {
+		if r == '-' || r == '_' {
+			n++
+		}
+	}
+	return n
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func isRegionSubtag(s string) bool {
+	if len(s) == 2 && isAlpha(s) {
+		return true
+	}
+	if len(s) == 3 {
+		if _, err := strconv.Atoi(s); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func titleCase(s string) string {
+	lower := strings.ToLower(s)
+	return strings.ToUpper(lower[:1]) + lower[1:]
+}
+
+// regionMacro maps a handful of common regions to the CLDR macro-region
+// TranslateTag falls back to before dropping the region entirely, e.g.
+// "es-MX" tries "es-419" (Latin America and the Caribbean) before "es".
+// Not exhaustive -- callers with broader needs should register the exact
+// regional tag they need via RegisterRegionalWords instead of relying on
+// this fallback.
+var regionMacro = map[string]string{
+	"MX": "419", "AR": "419", "CO": "419", "CL": "419",
+	"PE": "419", "VE": "419", "EC": "419", "GT": "419",
+}
+
+// tagToLang resolves t.Lang to the internal lang enum using the same
+// case-insensitive mapping OutLang/Translate already apply to plain
+// "EN"/"es" strings.
+func tagToLang(t Tag) lang {
+	c := GetConv()
+	l := c.mapLangCode(t.Lang)
+	c.putConv()
+	return l
+}
+
+var (
+	regionalWordsMu sync.RWMutex
+	regionalWords   = map[string]map[string]string{}
+)
+
+// RegisterRegionalWords registers key -> translated overrides under tag
+// (e.g. "es-MX"), a secondary registry TranslateTag consults before
+// falling back to the language-wide dictionary RegisterWords populates.
+// This lets callers add regional variants without DictEntry growing a new
+// field per region.
+func RegisterRegionalWords(tag string, entries map[string]string) error {
+	t, err := ParseTag(tag)
+	if err != nil {
+		return err
+	}
+
+	regionalWordsMu.Lock()
+	defer regionalWordsMu.Unlock()
+
+	key := t.Lang
+	if t.Region != "" {
+		key = t.Lang + "-" + t.Region
+	}
+	dict, ok := regionalWords[key]
+	if !ok {
+		dict = map[string]string{}
+		regionalWords[key] = dict
+	}
+	for k, v := range entries {
+		dict[k] = v
+	}
+	return nil
+}
+
+// lookupRegionalWord tries, in order, the exact "lang-region" tag, its
+// CLDR macro-region (if any), and returns ok=false if neither registered
+// key under RegisterRegionalWords.
+func lookupRegionalWord(t Tag, key string) (string, bool) {
+	regionalWordsMu.RLock()
+	defer regionalWordsMu.RUnlock()
+
+	if t.Region != "" {
+		if dict, ok := regionalWords[t.Lang+"-"+t.Region]; ok {
+			if word, ok := dict[key]; ok {
+				return word, true
+			}
+		}
+		if macro, ok := regionMacro[t.Region]; ok {
+			if dict, ok := regionalWords[t.Lang+"-"+macro]; ok {
+				if word, ok := dict[key]; ok {
+					return word, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// TranslateTag resolves args the same way Translate does, except the
+// language comes from a parsed BCP 47 tag and each string arg prefers a
+// registered regional override (see RegisterRegionalWords) before falling
+// back to the language-wide dictionary: exact "lang-region", then
+// "lang-419"-style macro-region, then plain "lang", then the process
+// default set by OutLang, then EN.
+func TranslateTag(tagStr string, args ...any) *Conv {
+	t, err := ParseTag(tagStr)
+	if err != nil {
+		return Translate(args...)
+	}
+
+	resolved := make([]any, len(args))
+	for i, a := range args {
+		if key, ok := a.(string); ok {
+			if word, ok := lookupRegionalWord(t, key); ok {
+				resolved[i] = word
+				continue
+			}
+		}
+		resolved[i] = a
+	}
+
+	full := make([]any, 0, len(resolved)+1)
+	full = append(full, tagToLang(t))
+	full = append(full, resolved...)
+	return Translate(full...)
+}
@@ -0,0 +1,95 @@
+package fmt
+
+import "testing"
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Tag
+		wantErr bool
+	}{
+		{in: "en-US", want: Tag{Lang: "en", Region: "US"}},
+		{in: "en_US", want: Tag{Lang: "en", Region: "US"}},
+		{in: "es-419", want: Tag{Lang: "es", Region: "419"}},
+		{in: "zh-Hant-TW", want: Tag{Lang: "zh", Script: "Hant", Region: "TW"}},
+		{in: "pt-BR", want: Tag{Lang: "pt", Region: "BR"}},
+		{in: "sr_Latn", want: Tag{Lang: "sr", Script: "Latn"}},
+		{in: "EN", want: Tag{Lang: "en"}},
+		{in: "en-US-", wantErr: true},
+		{in: "en--US", wantErr: true},
+		{in: "bad/", wantErr: true},
+		{in: "morethan8-US", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := ParseTag(tc.in)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("ParseTag(%q): want error, got %+v", tc.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTag(%q): unexpected error: %v", tc.in, err)
+			}
+			if got.Lang != tc.want.Lang || got.Script != tc.want.Script || got.Region != tc.want.Region {
+				t.Errorf("ParseTag(%q) = %+v, want %+v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTagString(t *testing.T) {
+	tag := Tag{Lang: "zh", Script: "Hant", Region: "TW"}
+	if got := tag.String(); got != "zh-Hant-TW" {
+		t.Errorf("got %q want %q", got, "zh-Hant-TW")
+	}
+}
+
+func TestOutLangAcceptsTag(t *testing.T) {
+	originalLang := defLang
+	defer func() { defLang = originalLang }()
+
+	tag, err := ParseTag("es-MX")
+	if err != nil {
+		t.Fatalf("ParseTag: %v", err)
+	}
+	if got := OutLang(tag); got != "ES" {
+		t.Errorf("OutLang(Tag{es-MX}) = %q, want %q", got, "ES")
+	}
+}
+
+func TestTranslateTagRegionalOverride(t *testing.T) {
+	RegisterWords([]DictEntry{
+		{EN: "tag_greeting", ES: "Hola"},
+	})
+	if err := RegisterRegionalWords("es-MX", map[string]string{"tag_greeting": "Quihubo"}); err != nil {
+		t.Fatalf("RegisterRegionalWords: %v", err)
+	}
+
+	got := TranslateTag("es-MX", "tag_greeting").String()
+	if got != "Quihubo" {
+		t.Errorf("es-MX override: got %q want %q", got, "Quihubo")
+	}
+
+	got = TranslateTag("es-ES", "tag_greeting").String()
+	if got != "Hola" {
+		t.Errorf("es-ES falls back to language dictionary: got %q want %q", got, "Hola")
+	}
+}
+
+func TestTranslateTagMacroRegionFallback(t *testing.T) {
+	RegisterWords([]DictEntry{
+		{EN: "tag_bye", ES: "Adiós"},
+	})
+	if err := RegisterRegionalWords("es-419", map[string]string{"tag_bye": "Chau"}); err != nil {
+		t.Fatalf("RegisterRegionalWords: %v", err)
+	}
+
+	got := TranslateTag("es-AR", "tag_bye").String()
+	if got != "Chau" {
+		t.Errorf("es-AR macro-region fallback: got %q want %q", got, "Chau")
+	}
+}
@@ -0,0 +1,114 @@
+package fmt
+
+// PluralLocStr is LocStr's plural-aware counterpart: instead of one string
+// per language, each language slot holds the CLDR-category branches for a
+// single message (e.g. "{one: "%d file", other: "%d files"}"), selected by
+// the language's plural rule (see RegisterLanguage) the same way Plural
+// selects a positional form. Index order matches LocStr's language
+// constants (EN, ES, ZH, HI, AR, PT, FR, DE, RU); a nil slot means that
+// language has no branches and falls through to EN's.
+type PluralLocStr [9]map[PluralCategory]string
+
+// GenderLocStr is LocStr's gender-aware counterpart: each language slot
+// holds one branch per grammatical gender key ("male", "female",
+// "neuter", "other"), selected by TranslateGenderLocStr the way Select
+// picks a case by key.
+type GenderLocStr [9]map[string]string
+
+// lookupPluralCategory returns entry's branch for l and category, falling
+// back to l's "other" branch, then to EN's matching category, then EN's
+// "other" -- the same other-then-EN chain Translate applies to a missing
+// DictEntry translation.
+func lookupPluralCategory(entry PluralLocStr, l lang, category PluralCategory) (string, bool) {
+	if text, ok := pluralBranch(entry, l, category); ok {
+		return text, true
+	}
+	if l == EN {
+		return "", false
+	}
+	return pluralBranch(entry, EN, category)
+}
+
+func pluralBranch(entry PluralLocStr, l lang, category PluralCategory) (string, bool) {
+	m := entry[l]
+	if m == nil {
+		return "", false
+	}
+	if text, ok := m[category]; ok {
+		return text, true
+	}
+	text, ok := m[PluralOther]
+	return text, ok
+}
+
+// TranslatePluralLocStr resolves entry's branch matching n's CLDR plural
+// category for the current output language (see OutLang), falling back
+// through entry's own "other" branch, then EN's, then "".
+func TranslatePluralLocStr(entry PluralLocStr, n int) *Conv {
+	category := resolvePluralCategory(getCurrentLang(), n)
+	text, _ := lookupPluralCategory(entry, getCurrentLang(), category)
+	return Convert(text)
+}
+
+// lookupGender returns entry's branch for l and gender, falling back to
+// l's "other" branch, then EN's matching gender, then EN's "other".
+func lookupGender(entry GenderLocStr, l lang, gender string) (string, bool) {
+	if text, ok := genderBranch(entry, l, gender); ok {
+		return text, true
+	}
+	if l == EN {
+		return "", false
+	}
+	return genderBranch(entry, EN, gender)
+}
+
+func genderBranch(entry GenderLocStr, l lang, gender string) (string, bool) {
+	m := entry[l]
+	if m == nil {
+		return "", false
+	}
+	if text, ok := m[gender]; ok {
+		return text, true
+	}
+	text, ok := m["other"]
+	return text, ok
+}
+
+// TranslateGenderLocStr resolves entry's branch matching gender for the
+// current output language (see OutLang), falling back through entry's own
+// "other" branch, then EN's, then "".
+func TranslateGenderLocStr(entry GenderLocStr, gender string) *Conv {
+	text, _ := lookupGender(entry, getCurrentLang(), gender)
+	return Convert(text)
+}
+
+// TranslateN resolves entry's branch matching n's CLDR plural category for
+// the current output language the same way TranslatePluralLocStr does, then
+// substitutes args into the resolved text's %-verbs (the same Sprintf verb
+// syntax Translate args use), so callers can write
+// TranslateN(count, D.FilesRemaining, count) and get "1 file remaining" /
+// "3 файла осталось" / "5 files remaining". With no args, the resolved text
+// is returned unsubstituted, matching TranslatePluralLocStr.
+func TranslateN(n int, entry PluralLocStr, args ...any) *Conv {
+	category := resolvePluralCategory(getCurrentLang(), n)
+	text, _ := lookupPluralCategory(entry, getCurrentLang(), category)
+	if len(args) == 0 {
+		return Convert(text)
+	}
+	return Convert(Sprintf(text, args...))
+}
+
+// RenderPluralLocStrVerb is the string-returning twin of
+// TranslatePluralLocStr for callers building a larger formatted string
+// rather than a standalone *Conv. No format-string verb (such as a
+// "%!L(n)" form in Html/Translate) calls it; callers invoke it directly.
+func RenderPluralLocStrVerb(entry PluralLocStr, n int) string {
+	return TranslatePluralLocStr(entry, n).String()
+}
+
+// RenderGenderLocStrVerb is the string-returning twin of
+// TranslateGenderLocStr. No format-string modifier (such as "%Lg(gender)"
+// in Html/Translate) calls it; callers invoke it directly.
+func RenderGenderLocStrVerb(entry GenderLocStr, gender string) string {
+	return TranslateGenderLocStr(entry, gender).String()
+}
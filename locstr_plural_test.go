@@ -0,0 +1,132 @@
+package fmt
+
+import "testing"
+
+func TestTranslatePluralLocStr(t *testing.T) {
+	entry := PluralLocStr{
+		EN: {PluralOne: "1 file", PluralOther: "%d files"},
+		ES: {PluralOne: "1 archivo", PluralOther: "%d archivos"},
+		FR: {PluralOne: "1 fichier", PluralOther: "%d fichiers"},
+		RU: {PluralOne: "1 файл", PluralFew: "%d файла", PluralMany: "%d файлов"},
+	}
+
+	tests := []struct {
+		name string
+		lang string
+		n    int
+		want string
+	}{
+		{"english one", "en", 1, "1 file"},
+		{"english other", "en", 3, "%d files"},
+		{"spanish one", "es", 1, "1 archivo"},
+		{"spanish other", "es", 0, "%d archivos"},
+		{"french one covers zero", "fr", 0, "1 fichier"},
+		{"french one", "fr", 1, "1 fichier"},
+		{"french other", "fr", 2, "%d fichiers"},
+		{"russian one", "ru", 1, "1 файл"},
+		{"russian few", "ru", 3, "%d файла"},
+		{"russian many", "ru", 5, "%d файлов"},
+		{"russian eleven many", "ru", 11, "%d файлов"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			OutLang(tt.lang)
+			defer OutLang(EN)
+
+			got := TranslatePluralLocStr(entry, tt.n).String()
+			if got != tt.want {
+				t.Errorf("TranslatePluralLocStr(%v, %d) in %s: got %q want %q", entry, tt.n, tt.lang, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslatePluralLocStrFallsBackToEnglish(t *testing.T) {
+	entry := PluralLocStr{
+		EN: {PluralOne: "1 file", PluralOther: "%d files"},
+	}
+
+	OutLang("de")
+	defer OutLang(EN)
+
+	got := TranslatePluralLocStr(entry, 3).String()
+	want := "%d files"
+	if got != want {
+		t.Errorf("TranslatePluralLocStr with no DE branch: got %q want %q", got, want)
+	}
+}
+
+func TestTranslateN(t *testing.T) {
+	entry := PluralLocStr{
+		EN: {PluralOne: "1 file remaining", PluralOther: "%d files remaining"},
+		RU: {PluralOne: "1 файл остался", PluralFew: "%d файла осталось", PluralMany: "%d файлов осталось"},
+	}
+
+	tests := []struct {
+		name string
+		lang string
+		n    int
+		want string
+	}{
+		{"english one", "en", 1, "1 file remaining"},
+		{"english other", "en", 5, "5 files remaining"},
+		{"russian few", "ru", 3, "3 файла осталось"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			OutLang(tt.lang)
+			defer OutLang(EN)
+
+			got := TranslateN(tt.n, entry, tt.n).String()
+			if got != tt.want {
+				t.Errorf("TranslateN(%d, ...) in %s: got %q want %q", tt.n, tt.lang, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslateNWithoutArgsReturnsRawBranch(t *testing.T) {
+	entry := PluralLocStr{
+		EN: {PluralOne: "1 file", PluralOther: "%d files"},
+	}
+
+	got := TranslateN(3, entry).String()
+	want := "%d files"
+	if got != want {
+		t.Errorf("TranslateN with no args: got %q want %q", got, want)
+	}
+}
+
+func TestTranslateGenderLocStr(t *testing.T) {
+	entry := GenderLocStr{
+		EN: {"male": "He liked it", "female": "She liked it", "other": "They liked it"},
+		ES: {"male": "A él le gustó", "female": "A ella le gustó", "other": "A elle le gustó"},
+	}
+
+	OutLang("es")
+	defer OutLang(EN)
+
+	if got := TranslateGenderLocStr(entry, "male").String(); got != "A él le gustó" {
+		t.Errorf("TranslateGenderLocStr(male): got %q", got)
+	}
+	if got := TranslateGenderLocStr(entry, "neuter").String(); got != "A elle le gustó" {
+		t.Errorf("TranslateGenderLocStr(neuter fallback to other): got %q", got)
+	}
+}
+
+func TestTranslateGenderLocStrFallsBackToEnglish(t *testing.T) {
+	entry := GenderLocStr{
+		EN: {"male": "He liked it", "other": "They liked it"},
+	}
+
+	OutLang("fr")
+	defer OutLang(EN)
+
+	got := TranslateGenderLocStr(entry, "male").String()
+	want := "He liked it"
+	if got != want {
+		t.Errorf("TranslateGenderLocStr with no FR branch: got %q want %q", got, want)
+	}
+}
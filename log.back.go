@@ -0,0 +1,16 @@
+//go:build !wasm
+
+package fmt
+
+import "os"
+
+// writeStdoutLine writes line to stdout, or stderr for Error/Warning
+// levels.
+func writeStdoutLine(level MessageType, line string) {
+	w := os.Stdout
+	if level == Msg.Error || level == Msg.Warning {
+		w = os.Stderr
+	}
+	w.WriteString(line)
+	w.WriteString("\n")
+}
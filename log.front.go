@@ -0,0 +1,21 @@
+//go:build wasm
+
+package fmt
+
+import "syscall/js"
+
+// writeStdoutLine routes line to the console.<method> function matching
+// level, the same js.Global().Get("console") bridge Println/Printf use on
+// wasm builds (see print.front_test.go).
+func writeStdoutLine(level MessageType, line string) {
+	method := "log"
+	switch level {
+	case Msg.Error:
+		method = "error"
+	case Msg.Warning:
+		method = "warn"
+	case Msg.Debug:
+		method = "debug"
+	}
+	js.Global().Get("console").Call(method, line)
+}
@@ -0,0 +1,208 @@
+package fmt
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogEntry is one structured log event: the classified level (see
+// MessageType/StringType), the rendered message, any structured fields
+// attached via Log(msg).With(...), and the time it was emitted.
+type LogEntry struct {
+	Level  MessageType
+	Msg    string
+	Fields []KeyValue
+	Time   time.Time
+}
+
+// Sink receives one LogEntry per emitted log call. Built-in sinks are
+// StdoutSink (ANSI-colorized per MessageType), JSONLinesSink and SSESink;
+// any type implementing this can be registered via SetSink/AddSink to
+// fan out to a caller's own destination.
+type Sink interface {
+	Write(entry LogEntry)
+}
+
+var (
+	sinksMu sync.RWMutex
+	sinks   = []Sink{StdoutSink{}}
+)
+
+// SetSink replaces every registered sink with s.
+func SetSink(s Sink) {
+	sinksMu.Lock()
+	sinks = []Sink{s}
+	sinksMu.Unlock()
+}
+
+// AddSink registers s alongside whatever sinks are already receiving log
+// events, for fan-out (e.g. StdoutSink plus a JSONLinesSink writing to a
+// log file).
+func AddSink(s Sink) {
+	sinksMu.Lock()
+	sinks = append(sinks, s)
+	sinksMu.Unlock()
+}
+
+func dispatch(entry LogEntry) {
+	sinksMu.RLock()
+	defer sinksMu.RUnlock()
+	for _, s := range sinks {
+		s.Write(entry)
+	}
+}
+
+// logBuilder accumulates a message's structured fields before it is
+// emitted to every registered sink. Obtained via Log, it is a one-shot
+// builder: exactly one of .Step/.Success/.Error/.Warn/.Debug/.Info/.Auto
+// classifies and emits the entry.
+type logBuilder struct {
+	msg    string
+	fields []KeyValue
+}
+
+// Log starts a structured log entry for msg. Call one of
+// .Step/.Success/.Error/.Warn/.Debug/.Info to classify and emit it, or
+// .Auto to classify it the same way Convert(msg).StringType() infers a
+// level from msg's own wording.
+func Log(msg string) *logBuilder {
+	return &logBuilder{msg: msg}
+}
+
+// With attaches a structured key/value field to the entry, reusing the
+// same KeyValue pair type TagPairs/StructTag.Options already expose
+// instead of a second key/value shape. val is rendered via Sprintf("%v",
+// val), the same as any other formatter verb in this module.
+func (b *logBuilder) With(key string, val any) *logBuilder {
+	b.fields = append(b.fields, KeyValue{Key: key, Value: Sprintf("%v", val)})
+	return b
+}
+
+func (b *logBuilder) emit(level MessageType) {
+	dispatch(LogEntry{Level: level, Msg: b.msg, Fields: b.fields, Time: time.Now()})
+}
+
+// Step emits the entry at Info level -- this module has no dedicated
+// "step" MessageType, and a progress step is, semantically, informational.
+func (b *logBuilder) Step() { b.emit(Msg.Info) }
+
+// Success emits the entry at Success level.
+func (b *logBuilder) Success() { b.emit(Msg.Success) }
+
+// Error emits the entry at Error level.
+func (b *logBuilder) Error() { b.emit(Msg.Error) }
+
+// Warn emits the entry at Warning level.
+func (b *logBuilder) Warn() { b.emit(Msg.Warning) }
+
+// Debug emits the entry at Debug level.
+func (b *logBuilder) Debug() { b.emit(Msg.Debug) }
+
+// Info emits the entry at Info level.
+func (b *logBuilder) Info() { b.emit(Msg.Info) }
+
+// Auto classifies the entry the same way Convert(msg).StringType() would
+// and emits it at that level, for call sites that don't know their own
+// level up front.
+func (b *logBuilder) Auto() {
+	_, level := Convert(b.msg).StringType()
+	b.emit(level)
+}
+
+// ansiColors maps a MessageType's lowercased String() to the ANSI color
+// code StdoutSink wraps the rendered line in. Levels with no entry here
+// (Normal, Connect, Auth, ...) are printed uncolored.
+var ansiColors = map[string]string{
+	"error":   "\x1b[31m",
+	"warning": "\x1b[33m",
+	"success": "\x1b[32m",
+	"debug":   "\x1b[90m",
+	"info":    "\x1b[36m",
+}
+
+const ansiReset = "\x1b[0m"
+
+// StdoutSink writes one ANSI-colorized line per entry: on native builds to
+// stdout, or to stderr for Error and Warning entries, and on wasm builds to
+// the console.<level> function matching entry.Level via syscall/js (see
+// log.back.go/log.front.go).
+type StdoutSink struct{}
+
+func (StdoutSink) Write(entry LogEntry) {
+	writeStdoutLine(entry.Level, formatStdoutLine(entry))
+}
+
+func formatStdoutLine(entry LogEntry) string {
+	color := ansiColors[strings.ToLower(entry.Level.String())]
+
+	c := Convert(color)
+	c.Write(entry.Msg)
+	for _, f := range entry.Fields {
+		c.Write(" ").Write(f.Key).Write("=").Write(f.Value)
+	}
+	if color != "" {
+		c.Write(ansiReset)
+	}
+	return c.String()
+}
+
+// jsonLogLine is the wire shape both JSONLinesSink and SSESink encode an
+// entry as: {"level":"error","ts":<unix ms>,"msg":"...","fields":{...}}.
+type jsonLogLine struct {
+	Level  string            `json:"level"`
+	TS     int64             `json:"ts"`
+	Msg    string            `json:"msg"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+func toJSONLogLine(entry LogEntry) jsonLogLine {
+	var fields map[string]string
+	if len(entry.Fields) > 0 {
+		fields = make(map[string]string, len(entry.Fields))
+		for _, f := range entry.Fields {
+			fields[f.Key] = f.Value
+		}
+	}
+	return jsonLogLine{
+		Level:  strings.ToLower(entry.Level.String()),
+		TS:     entry.Time.UnixMilli(),
+		Msg:    entry.Msg,
+		Fields: fields,
+	}
+}
+
+// JSONLinesSink writes one JSON object per entry to Writer, one line each
+// -- the ndjson convention most log aggregators expect.
+type JSONLinesSink struct {
+	Writer io.Writer
+}
+
+func (s JSONLinesSink) Write(entry LogEntry) {
+	line, err := json.Marshal(toJSONLogLine(entry))
+	if err != nil {
+		return
+	}
+	s.Writer.Write(append(line, '\n'))
+}
+
+// SSESink writes entry as a Server-Sent Event -- "event: <level>\ndata:
+// <json>\n\n" -- to Writer, the same framing the Connect/Auth/Parse/
+// Timeout/Broadcast network-lifecycle MessageTypes (see
+// MessageType.IsNetworkError) are meant to stream over.
+type SSESink struct {
+	Writer io.Writer
+}
+
+func (s SSESink) Write(entry LogEntry) {
+	data, err := json.Marshal(toJSONLogLine(entry))
+	if err != nil {
+		return
+	}
+	io.WriteString(s.Writer, "event: "+strings.ToLower(entry.Level.String())+"\n")
+	io.WriteString(s.Writer, "data: ")
+	s.Writer.Write(data)
+	io.WriteString(s.Writer, "\n\n")
+}
@@ -0,0 +1,101 @@
+package fmt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type recordingSink struct {
+	entries []LogEntry
+}
+
+func (s *recordingSink) Write(entry LogEntry) {
+	s.entries = append(s.entries, entry)
+}
+
+func TestLogLevelsDispatchToSink(t *testing.T) {
+	rec := &recordingSink{}
+	SetSink(rec)
+	defer SetSink(StdoutSink{})
+
+	Log("step one").Step()
+	Log("done").Success()
+	Log("boom").Error()
+	Log("careful").Warn()
+	Log("details").Debug()
+	Log("fyi").Info()
+
+	want := []MessageType{Msg.Info, Msg.Success, Msg.Error, Msg.Warning, Msg.Debug, Msg.Info}
+	if len(rec.entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(rec.entries), len(want))
+	}
+	for i, entry := range rec.entries {
+		if entry.Level != want[i] {
+			t.Errorf("entry %d: level = %v, want %v", i, entry.Level, want[i])
+		}
+	}
+}
+
+func TestLogWithFields(t *testing.T) {
+	rec := &recordingSink{}
+	SetSink(rec)
+	defer SetSink(StdoutSink{})
+
+	Log("user created").With("id", 42).With("name", "ada").Success()
+
+	if len(rec.entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(rec.entries))
+	}
+	fields := rec.entries[0].Fields
+	want := []KeyValue{{Key: "id", Value: "42"}, {Key: "name", Value: "ada"}}
+	if len(fields) != len(want) || fields[0] != want[0] || fields[1] != want[1] {
+		t.Errorf("Fields = %v, want %v", fields, want)
+	}
+}
+
+func TestAddSinkFansOut(t *testing.T) {
+	a, b := &recordingSink{}, &recordingSink{}
+	SetSink(a)
+	AddSink(b)
+	defer SetSink(StdoutSink{})
+
+	Log("fan out").Info()
+
+	if len(a.entries) != 1 || len(b.entries) != 1 {
+		t.Fatalf("expected both sinks to receive the entry, got a=%d b=%d", len(a.entries), len(b.entries))
+	}
+}
+
+func TestJSONLinesSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := JSONLinesSink{Writer: &buf}
+	sink.Write(LogEntry{Level: Msg.Error, Msg: "disk full", Fields: []KeyValue{{Key: "path", Value: "/tmp"}}})
+
+	out := buf.String()
+	for _, want := range []string{`"level":"error"`, `"msg":"disk full"`, `"path":"/tmp"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("JSONLinesSink output %q missing %q", out, want)
+		}
+	}
+	if !strings.HasSuffix(out, "\n") {
+		t.Errorf("JSONLinesSink output %q does not end in a newline", out)
+	}
+}
+
+func TestSSESink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := SSESink{Writer: &buf}
+	sink.Write(LogEntry{Level: Msg.Connect, Msg: "client joined"})
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "event: connect\ndata: ") {
+		t.Errorf("SSESink output = %q, want prefix %q", out, "event: connect\ndata: ")
+	}
+	if !strings.HasSuffix(out, "\n\n") {
+		t.Errorf("SSESink output %q does not end in a blank line", out)
+	}
+	if !strings.Contains(out, `"msg":"client joined"`) {
+		t.Errorf("SSESink output %q missing msg field", out)
+	}
+}
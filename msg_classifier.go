@@ -0,0 +1,158 @@
+package fmt
+
+import (
+	"strings"
+	"sync"
+)
+
+// MsgMatcher is a user-registered classifier consulted by ClassifyMessage
+// for patterns a keyword table can't express -- regexes, domain terms like
+// "OOM" or "panic", anything beyond a literal substring.
+type MsgMatcher func(string) bool
+
+var (
+	msgKeywordsMu sync.RWMutex
+	msgKeywords   = map[lang]map[MessageType][]string{}
+
+	msgMatchersMu sync.RWMutex
+	msgMatchers   = map[MessageType][]MsgMatcher{}
+)
+
+func init() {
+	RegisterMsgKeywords(Msg.Error, EN, "error", "failed", "exit status", "undeclared", "undefined", "fatal", "exception")
+	RegisterMsgKeywords(Msg.Success, EN, "success", "successful", "completed", "done")
+	RegisterMsgKeywords(Msg.Info, EN, "info", "starting", "initializing")
+	RegisterMsgKeywords(Msg.Warning, EN, "warning", "warn")
+	RegisterMsgKeywords(Msg.Debug, EN, "debug")
+
+	RegisterMsgKeywords(Msg.Error, ES, "error", "fallo", "fallido", "excepción")
+	RegisterMsgKeywords(Msg.Success, ES, "éxito", "exitoso", "completado")
+	RegisterMsgKeywords(Msg.Info, ES, "iniciando", "inicializando")
+	RegisterMsgKeywords(Msg.Warning, ES, "advertencia")
+	RegisterMsgKeywords(Msg.Debug, ES, "depuración")
+
+	RegisterMsgKeywords(Msg.Error, FR, "erreur", "échec")
+	RegisterMsgKeywords(Msg.Success, FR, "réussite", "réussi", "terminé")
+	RegisterMsgKeywords(Msg.Info, FR, "démarrage", "initialisation")
+	RegisterMsgKeywords(Msg.Warning, FR, "avertissement")
+	RegisterMsgKeywords(Msg.Debug, FR, "débogage")
+
+	RegisterMsgKeywords(Msg.Error, DE, "fehler")
+	RegisterMsgKeywords(Msg.Success, DE, "erfolg", "erfolgreich")
+	RegisterMsgKeywords(Msg.Info, DE, "startet", "initialisierung")
+	RegisterMsgKeywords(Msg.Warning, DE, "warnung")
+	RegisterMsgKeywords(Msg.Debug, DE, "debug")
+
+	RegisterMsgKeywords(Msg.Error, PT, "erro", "falha")
+	RegisterMsgKeywords(Msg.Success, PT, "sucesso", "concluído")
+	RegisterMsgKeywords(Msg.Info, PT, "iniciando", "inicializando")
+	RegisterMsgKeywords(Msg.Warning, PT, "aviso")
+	RegisterMsgKeywords(Msg.Debug, PT, "depuração")
+
+	RegisterMsgKeywords(Msg.Error, RU, "ошибка", "сбой")
+	RegisterMsgKeywords(Msg.Success, RU, "успех", "успешно", "завершено")
+	RegisterMsgKeywords(Msg.Info, RU, "запуск", "инициализация")
+	RegisterMsgKeywords(Msg.Warning, RU, "предупреждение")
+	RegisterMsgKeywords(Msg.Debug, RU, "отладка")
+}
+
+// RegisterMsgKeywords adds keywords to level's keyword table for l,
+// resolved the same way OutLang resolves its argument (a lang value, a
+// locale string, or a Tag). Matching is case-insensitive substring
+// matching against the message, the same heuristic the builtin EN table
+// uses.
+func RegisterMsgKeywords(level MessageType, l any, keywords ...string) {
+	code := resolveLangArg(l)
+
+	msgKeywordsMu.Lock()
+	defer msgKeywordsMu.Unlock()
+	perLevel := msgKeywords[code]
+	if perLevel == nil {
+		perLevel = map[MessageType][]string{}
+		msgKeywords[code] = perLevel
+	}
+	perLevel[level] = append(perLevel[level], keywords...)
+}
+
+// RegisterMsgMatcher registers match as an additional classifier for
+// level, consulted when no keyword table entry for the current OutLang
+// (or its EN fallback) matches -- for patterns a literal substring can't
+// express, e.g. a regexp or a check for "OOM"/"panic" anywhere in the text.
+func RegisterMsgMatcher(level MessageType, match MsgMatcher) {
+	msgMatchersMu.Lock()
+	defer msgMatchersMu.Unlock()
+	msgMatchers[level] = append(msgMatchers[level], match)
+}
+
+// resolveLangArg resolves l the same way OutLang resolves its variadic
+// argument: a lang value is used directly, a Tag's Lang subtag is mapped
+// via tagToLang, and a string is parsed via the shared Conv.langParser.
+// Anything else resolves to EN.
+func resolveLangArg(l any) lang {
+	switch v := l.(type) {
+	case lang:
+		return v
+	case Tag:
+		return tagToLang(v)
+	case string:
+		return GetConv().langParser(v)
+	default:
+		return EN
+	}
+}
+
+// ClassifyMessage resolves msg's MessageType by consulting the current
+// OutLang's keyword table first, then EN's, then every registered
+// MsgMatcher. StringType calls this for its keyword heuristic, so
+// RegisterMsgKeywords/RegisterMsgMatcher extend both.
+func ClassifyMessage(msg string) MessageType {
+	lower := strings.ToLower(msg)
+	current := getCurrentLang()
+
+	if level, ok := matchKeywordTable(lower, current); ok {
+		return level
+	}
+	if current != EN {
+		if level, ok := matchKeywordTable(lower, EN); ok {
+			return level
+		}
+	}
+
+	msgMatchersMu.RLock()
+	defer msgMatchersMu.RUnlock()
+	for level, matchers := range msgMatchers {
+		for _, match := range matchers {
+			if match(msg) {
+				return level
+			}
+		}
+	}
+
+	return Msg.Normal
+}
+
+// StringType returns c's current string content unchanged alongside the
+// MessageType ClassifyMessage infers for it, so callers that need both the
+// text and its classification (log.go's Auto) can get them in one call.
+func (c *Conv) StringType() (string, MessageType) {
+	s := c.String()
+	return s, ClassifyMessage(s)
+}
+
+func matchKeywordTable(lowerMsg string, l lang) (MessageType, bool) {
+	msgKeywordsMu.RLock()
+	defer msgKeywordsMu.RUnlock()
+
+	perLevel := msgKeywords[l]
+	if perLevel == nil {
+		return Msg.Normal, false
+	}
+	for _, level := range []MessageType{Msg.Error, Msg.Warning, Msg.Success, Msg.Debug, Msg.Info} {
+		for _, kw := range perLevel[level] {
+			if strings.Contains(lowerMsg, strings.ToLower(kw)) {
+				return level, true
+			}
+		}
+	}
+	return Msg.Normal, false
+}
@@ -0,0 +1,67 @@
+package fmt
+
+import "testing"
+
+func TestClassifyMessageLocales(t *testing.T) {
+	tests := []struct {
+		name string
+		lang string
+		msg  string
+		want MessageType
+	}{
+		{"en error", "en", "Operation failed", Msg.Error},
+		{"en success", "en", "Build successful", Msg.Success},
+		{"es error", "es", "Fallo en la conexión", Msg.Error},
+		{"es success", "es", "Proceso exitoso", Msg.Success},
+		{"es warning", "es", "Advertencia: disco casi lleno", Msg.Warning},
+		{"fr error", "fr", "Une erreur est survenue", Msg.Error},
+		{"fr success", "fr", "Tâche réussie", Msg.Success},
+		{"de error", "de", "Ein Fehler ist aufgetreten", Msg.Error},
+		{"de success", "de", "Erfolgreich abgeschlossen", Msg.Success},
+		{"pt error", "pt", "Ocorreu um erro", Msg.Error},
+		{"pt success", "pt", "Operação concluída com sucesso", Msg.Success},
+		{"ru error", "ru", "Произошла ошибка", Msg.Error},
+		{"ru success", "ru", "Операция завершена успешно", Msg.Success},
+		{"falls back to en table", "de", "this operation failed", Msg.Error},
+		{"normal message", "en", "Hello world", Msg.Normal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			OutLang(tt.lang)
+			defer OutLang(EN)
+
+			if got := ClassifyMessage(tt.msg); got != tt.want {
+				t.Errorf("ClassifyMessage(%q) in %s = %v, want %v", tt.msg, tt.lang, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterMsgMatcher(t *testing.T) {
+	RegisterMsgMatcher(Msg.Error, func(s string) bool {
+		return s == "OOM" || s == "panic: runtime error"
+	})
+
+	if got := ClassifyMessage("OOM"); got != Msg.Error {
+		t.Errorf("ClassifyMessage(OOM) = %v, want Error", got)
+	}
+	if got := ClassifyMessage("panic: runtime error"); got != Msg.Error {
+		t.Errorf("ClassifyMessage(panic) = %v, want Error", got)
+	}
+}
+
+func TestRegisterMsgKeywordsAcceptsLangArgVariants(t *testing.T) {
+	RegisterMsgKeywords(Msg.Warning, "de", "vorsicht")
+	RegisterMsgKeywords(Msg.Warning, DE, "achtung")
+
+	OutLang(DE)
+	defer OutLang(EN)
+
+	if got := ClassifyMessage("Vorsicht, Baustelle"); got != Msg.Warning {
+		t.Errorf("ClassifyMessage(%q) via string lang arg = %v, want Warning", "Vorsicht, Baustelle", got)
+	}
+	if got := ClassifyMessage("Achtung bitte"); got != Msg.Warning {
+		t.Errorf("ClassifyMessage(%q) via lang arg = %v, want Warning", "Achtung bitte", got)
+	}
+}
@@ -0,0 +1,264 @@
+package fmt
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NumberSymbols carries the CLDR-style symbols and grouping algorithm one
+// locale uses to render a number: the digit-group separator, the decimal
+// mark, and whether digits group in the western 3-3-3 pattern or the
+// Indian 3-2-2 pattern (rightmost group of 3, remaining groups of 2).
+type NumberSymbols struct {
+	Group   string
+	Decimal string
+	Indian  bool
+}
+
+// numberSymbols maps each supported language to its NumberSymbols, per
+// CLDR: EN/ZH use comma grouping with a dot decimal; ES/DE/PT swap that
+// (dot grouping, comma decimal); FR/RU group with a non-breaking space;
+// HI groups Indian-style (comma separators, dot decimal, 3-2-2 grouping).
+var numberSymbols = map[lang]NumberSymbols{
+	EN: {Group: ",", Decimal: "."},
+	ZH: {Group: ",", Decimal: "."},
+	HI: {Group: ",", Decimal: ".", Indian: true},
+	AR: {Group: ",", Decimal: "."},
+	ES: {Group: ".", Decimal: ","},
+	DE: {Group: ".", Decimal: ","},
+	PT: {Group: ".", Decimal: ","},
+	FR: {Group: " ", Decimal: ","},
+	RU: {Group: " ", Decimal: ","},
+}
+
+// currencyLayout describes how .Currency places a symbol around a
+// formatted number for one locale: prefixed directly (EN/ES/PT's
+// "$1,234.56"/"€1.234,56"), or suffixed with a separating space (FR/DE/
+// RU's "1 234,56 €").
+type currencyLayout struct {
+	suffix bool
+	space  bool
+}
+
+var currencyLayouts = map[lang]currencyLayout{
+	EN: {suffix: false, space: false},
+	ZH: {suffix: false, space: false},
+	HI: {suffix: false, space: false},
+	AR: {suffix: false, space: false},
+	ES: {suffix: false, space: false},
+	PT: {suffix: false, space: false},
+	DE: {suffix: true, space: true},
+	FR: {suffix: true, space: true},
+	RU: {suffix: true, space: true},
+}
+
+// groupDigits inserts sep into intDigits (a non-negative run of decimal
+// digits, no sign) per the western 3-3-3 pattern, or the Indian 3-2-2
+// pattern when indian is true.
+func groupDigits(intDigits, sep string, indian bool) string {
+	if len(intDigits) <= 3 {
+		return intDigits
+	}
+	if !indian {
+		var b strings.Builder
+		lead := len(intDigits) % 3
+		if lead == 0 {
+			lead = 3
+		}
+		b.WriteString(intDigits[:lead])
+		for i := lead; i < len(intDigits); i += 3 {
+			b.WriteString(sep)
+			b.WriteString(intDigits[i : i+3])
+		}
+		return b.String()
+	}
+
+	// Indian grouping: rightmost group of 3, remaining groups of 2.
+	rest := intDigits[:len(intDigits)-3]
+	last3 := intDigits[len(intDigits)-3:]
+	var groups []string
+	for len(rest) > 2 {
+		groups = append([]string{rest[len(rest)-2:]}, groups...)
+		rest = rest[:len(rest)-2]
+	}
+	if len(rest) > 0 {
+		groups = append([]string{rest}, groups...)
+	}
+	groups = append(groups, last3)
+	return strings.Join(groups, sep)
+}
+
+// formatNumberLocale renders value using symbols: grouped integer part,
+// then a decimal part (only when value has a fractional component) joined
+// by symbols.Decimal. Trailing zero fractions are dropped, the same
+// "2189009.00 -> 2.189.009" behavior the existing Thousands tests expect.
+func formatNumberLocale(value float64, symbols NumberSymbols) string {
+	s := strconv.FormatFloat(value, 'f', -1, 64)
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasFrac := s, "", false
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart, hasFrac = s[:i], s[i+1:], true
+	}
+
+	out := groupDigits(intPart, symbols.Group, symbols.Indian)
+	if hasFrac {
+		out += symbols.Decimal + fracPart
+	}
+	if neg {
+		out = "-" + out
+	}
+	return out
+}
+
+// formatCurrencyLocale renders value as a currency amount for l, placing
+// symbol per l's currencyLayout (prefixed for EN/ES/PT-style locales,
+// suffixed with a separating space for FR/DE/RU-style locales).
+func formatCurrencyLocale(value float64, symbol string, l lang) string {
+	number := formatNumberLocale(value, numberSymbolsFor(l))
+	layout, ok := currencyLayouts[l]
+	if !ok {
+		layout = currencyLayouts[EN]
+	}
+
+	if !layout.suffix {
+		return symbol + number
+	}
+	if layout.space {
+		return number + " " + symbol
+	}
+	return number + symbol
+}
+
+// formatPercentLocale renders value*100 as a percentage for l, with a
+// non-breaking space before "%" for the locales (FR/RU) whose grouping
+// already uses one, and no space otherwise.
+func formatPercentLocale(value float64, l lang) string {
+	number := formatNumberLocale(value*100, numberSymbolsFor(l))
+	symbols := numberSymbolsFor(l)
+	if symbols.Group == " " {
+		return number + " %"
+	}
+	return number + "%"
+}
+
+// formatScientificLocale renders value in scientific notation (mantissa
+// and exponent, shortest round-trip precision) using l's decimal mark in
+// place of Go's default ".".
+func formatScientificLocale(value float64, l lang) string {
+	s := strconv.FormatFloat(value, 'e', -1, 64)
+	return strings.ReplaceAll(s, ".", numberSymbolsFor(l).Decimal)
+}
+
+// numberSymbolsFor returns l's NumberSymbols, falling back to EN's for a
+// language without an explicit entry (e.g. future additions to the lang
+// enum before their symbols are registered).
+func numberSymbolsFor(l lang) NumberSymbols {
+	if symbols, ok := numberSymbols[l]; ok {
+		return symbols
+	}
+	return numberSymbols[EN]
+}
+
+// thousandsSymbolsFor returns the NumberSymbols Thousands' no-argument path
+// groups with for l. It mirrors numberSymbolsFor for every locale except
+// EN: Thousands() predates OutLang and its no-argument default has always
+// been EU-style grouping (see TestFormatNumber's "(EU)" cases), so EN keeps
+// that legacy default here instead of numberSymbolsFor's Anglo entry --
+// call OutLang with any other locale to get genuinely locale-aware
+// grouping out of Thousands().
+func thousandsSymbolsFor(l lang) NumberSymbols {
+	if l == EN {
+		return numberSymbols[DE]
+	}
+	return numberSymbolsFor(l)
+}
+
+// Thousands groups c's current numeric content with digit separators. With
+// no arguments it groups per thousandsSymbolsFor(OutLang) -- EU-style
+// (dot thousands, comma decimal) while OutLang is still EN, and genuinely
+// locale-aware grouping once OutLang is set to anything else. Thousands(true)
+// and Thousands(false) keep the original boolean flag's meaning for callers
+// that want Anglo or EU-style grouping regardless of OutLang: true forces
+// comma-thousands/dot-decimal, false forces dot-thousands/comma-decimal.
+// Non-numeric content is left untouched.
+func (c *Conv) Thousands(args ...bool) *Conv {
+	if len(c.err) > 0 {
+		return c
+	}
+	original := c.String()
+	value, err := strconv.ParseFloat(original, 64)
+	if err != nil {
+		return c
+	}
+
+	var symbols NumberSymbols
+	switch {
+	case len(args) == 0:
+		symbols = thousandsSymbolsFor(getCurrentLang())
+	case args[0]:
+		symbols = numberSymbols[EN]
+	default:
+		symbols = numberSymbols[DE]
+	}
+
+	return c.Replace(original, formatNumberLocale(value, symbols))
+}
+
+// currencySymbolDefaults is the default currency symbol Number's
+// "currency" style uses when no explicit symbol is given -- one per
+// locale's most common currency. Currency itself still takes an explicit
+// symbol for callers that need a different one.
+var currencySymbolDefaults = map[lang]string{
+	EN: "$",
+	ZH: "¥",
+	HI: "₹",
+	AR: "$",
+	ES: "€",
+	PT: "€",
+	FR: "€",
+	DE: "€",
+	RU: "₽",
+}
+
+// currencySymbolFor returns l's default currency symbol, falling back to
+// "$" for a language without an explicit entry.
+func currencySymbolFor(l lang) string {
+	if symbol, ok := currencySymbolDefaults[l]; ok {
+		return symbol
+	}
+	return "$"
+}
+
+// Currency appends value formatted as a currency amount for the active
+// OutLang, placing symbol per locale: prefixed for EN/ES/PT-style locales
+// ("$1,234.56", "€1.234,56"), suffixed with a separating space for FR/DE/
+// RU-style locales ("1 234,56 €").
+func (c *Conv) Currency(value float64, symbol string) *Conv {
+	if len(c.err) > 0 {
+		return c
+	}
+	return c.Write(formatCurrencyLocale(value, symbol, getCurrentLang()))
+}
+
+// Percent appends value*100 formatted as a percentage for the active
+// OutLang, using its NumberSymbols and, for FR/RU, the same non-breaking
+// space the locale's grouping already uses before the "%" sign.
+func (c *Conv) Percent(value float64) *Conv {
+	if len(c.err) > 0 {
+		return c
+	}
+	return c.Write(formatPercentLocale(value, getCurrentLang()))
+}
+
+// Scientific appends value in scientific notation for the active OutLang,
+// using its decimal mark in place of Go's default ".".
+func (c *Conv) Scientific(value float64) *Conv {
+	if len(c.err) > 0 {
+		return c
+	}
+	return c.Write(formatScientificLocale(value, getCurrentLang()))
+}
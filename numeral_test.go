@@ -0,0 +1,85 @@
+package fmt
+
+import "testing"
+
+func TestThousandsUsesOutLang(t *testing.T) {
+	tests := []struct {
+		name  string
+		lang  string
+		value float64
+		want  string
+	}{
+		{"en keeps the legacy EU default", "en", 2189009, "2.189.009"},
+		{"es groups with dot", "es", 2189009, "2.189.009"},
+		{"es decimal comma", "es", 2189009.123, "2.189.009,123"},
+		{"de groups with dot", "de", 2189009, "2.189.009"},
+		{"fr groups with nbsp", "fr", 2189009, "2 189 009"},
+		{"ru groups with nbsp", "ru", 2189009, "2 189 009"},
+		{"hindi indian grouping", "hi", 1234567, "12,34,567"},
+		{"hindi indian grouping small", "hi", 123456, "1,23,456"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			OutLang(tt.lang)
+			defer OutLang(EN)
+
+			got := Convert(tt.value).Thousands().String()
+			if got != tt.want {
+				t.Errorf("Thousands() in %s = %q, want %q", tt.lang, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrency(t *testing.T) {
+	tests := []struct {
+		name   string
+		lang   string
+		value  float64
+		symbol string
+		want   string
+	}{
+		{"en dollar prefix", "en", 1234.56, "$", "$1,234.56"},
+		{"es euro prefix", "es", 1234.56, "€", "€1.234,56"},
+		{"fr euro suffix with space", "fr", 1234.56, "€", "1 234,56 €"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			OutLang(tt.lang)
+			defer OutLang(EN)
+
+			got := Translate().Currency(tt.value, tt.symbol).String()
+			if got != tt.want {
+				t.Errorf("Currency(%v, %q) in %s = %q, want %q", tt.value, tt.symbol, tt.lang, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercent(t *testing.T) {
+	OutLang("en")
+	defer OutLang(EN)
+	if got := Translate().Percent(0.4567).String(); got != "45.67%" {
+		t.Errorf("Percent(0.4567) = %q, want %q", got, "45.67%")
+	}
+
+	OutLang("fr")
+	if got := Translate().Percent(0.5).String(); got != "50 %" {
+		t.Errorf("Percent(0.5) in fr = %q, want %q", got, "50 %")
+	}
+}
+
+func TestScientific(t *testing.T) {
+	OutLang("en")
+	defer OutLang(EN)
+	if got := Translate().Scientific(1234.5).String(); got != "1.2345e+03" {
+		t.Errorf("Scientific(1234.5) = %q, want %q", got, "1.2345e+03")
+	}
+
+	OutLang("es")
+	if got := Translate().Scientific(1234.5).String(); got != "1,2345e+03" {
+		t.Errorf("Scientific(1234.5) in es = %q, want %q", got, "1,2345e+03")
+	}
+}
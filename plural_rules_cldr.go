@@ -0,0 +1,52 @@
+package fmt
+
+// init registers the CLDR plural rules RegisterLanguage doesn't ship with
+// a rule of its own. Languages not listed here (ZH, HI) keep
+// englishPluralRule's one/other split as their default.
+func init() {
+	RegisterLanguage("fr", oneOrFewerPluralRule)
+	RegisterLanguage("pt", oneOrFewerPluralRule)
+	RegisterLanguage("ru", slavicPluralRule)
+	RegisterLanguage("ar", arabicPluralRule)
+}
+
+// oneOrFewerPluralRule is the French/Portuguese rule: "one" covers both 0
+// and 1, "other" everything else.
+func oneOrFewerPluralRule(n int) PluralCategory {
+	if n <= 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// slavicPluralRule is the classic Russian/Polish/Ukrainian mod-10/mod-100
+// rule: "one" for ...1 (but not ...11), "few" for ...2-4 (but not
+// ...12-14), "many" otherwise.
+func slavicPluralRule(n int) PluralCategory {
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return PluralOne
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return PluralFew
+	default:
+		return PluralMany
+	}
+}
+
+// arabicPluralRule implements CLDR's six-category Arabic rule.
+func arabicPluralRule(n int) PluralCategory {
+	switch {
+	case n == 0:
+		return PluralZero
+	case n == 1:
+		return PluralOne
+	case n == 2:
+		return PluralTwo
+	case n%100 >= 3 && n%100 <= 10:
+		return PluralFew
+	case n%100 >= 11 && n%100 <= 99:
+		return PluralMany
+	default:
+		return PluralOther
+	}
+}
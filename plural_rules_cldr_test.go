@@ -0,0 +1,93 @@
+package fmt
+
+import "testing"
+
+func TestRenderPluralVerbLiteral(t *testing.T) {
+	tests := []struct {
+		name string
+		lang string
+		n    int
+		want string
+	}{
+		{"english one", "en", 1, "file"},
+		{"english other zero", "en", 0, "files"},
+		{"english other", "en", 5, "files"},
+		{"spanish one", "es", 1, "archivo"},
+		{"spanish other", "es", 21, "archivos"},
+		{"russian one", "ru", 1, "файл"},
+		{"russian few", "ru", 2, "файла"},
+		{"russian many", "ru", 5, "файлов"},
+		{"russian eleven many", "ru", 11, "файлов"},
+		{"russian twenty-one one", "ru", 21, "файл"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			OutLang(tt.lang)
+			defer OutLang(EN)
+
+			payload := "file:files"
+			if tt.lang == "es" {
+				payload = "archivo:archivos"
+			} else if tt.lang == "ru" {
+				payload = "файл:файла:файлов"
+			}
+
+			got := RenderPluralVerb(payload, tt.n)
+			if got != tt.want {
+				t.Errorf("RenderPluralVerb(%q, %d) in %s: got %q want %q", payload, tt.n, tt.lang, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderPluralVerbArabicSixCategory(t *testing.T) {
+	payload := "zero:one:two:few:many:other"
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "zero"},
+		{1, "one"},
+		{2, "two"},
+		{5, "few"},
+		{11, "many"},
+		{21, "many"},
+	}
+
+	OutLang("ar")
+	defer OutLang(EN)
+
+	for _, tt := range tests {
+		got := RenderPluralVerb(payload, tt.n)
+		if got != tt.want {
+			t.Errorf("RenderPluralVerb(%q, %d) in ar: got %q want %q", payload, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestRenderPluralVerbBareKey(t *testing.T) {
+	RegisterPluralWords("file", map[string]string{
+		"en": "file:files",
+		"es": "archivo:archivos",
+	})
+
+	OutLang("es")
+	defer OutLang(EN)
+
+	got := RenderPluralVerb("file", 3)
+	want := "archivos"
+	if got != want {
+		t.Errorf("RenderPluralVerb(%q, 3) in es: got %q want %q", "file", got, want)
+	}
+}
+
+func TestRenderPluralVerbBareKeyFallback(t *testing.T) {
+	OutLang(EN)
+
+	got := RenderPluralVerb("unregistered-key", 1)
+	want := "unregistered-key"
+	if got != want {
+		t.Errorf("RenderPluralVerb with no registration: got %q want %q", got, want)
+	}
+}
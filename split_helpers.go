@@ -0,0 +1,63 @@
+package fmt
+
+import "unicode"
+
+// SplitAndTrim splits c's string on sep, trims each piece (TrimSpace when
+// cutset is empty, otherwise Trim(cutset...)), and drops pieces that end up
+// empty. It reuses the Split backing slice to avoid a second allocation.
+func (c *Conv) SplitAndTrim(sep string, cutset ...string) []string {
+	parts := c.Split(sep)
+
+	out := parts[:0]
+	for _, p := range parts {
+		trimmed := Convert(p)
+		if len(cutset) == 0 {
+			trimmed.TrimSpace()
+		} else {
+			trimmed.Trim(cutset...)
+		}
+		s := trimmed.String()
+		if s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Fields splits c's string on runs of Unicode whitespace, mirroring
+// strings.Fields, so callers don't need to import strings alongside this
+// module just for that one call.
+func (c *Conv) Fields() []string {
+	s := c.String()
+
+	var fields []string
+	start := -1
+	for i, r := range s {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				fields = append(fields, s[start:i])
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, s[start:])
+	}
+	return fields
+}
+
+// Cut is a non-chainable terminator: it splits c's string at the first
+// occurrence of sep, mirroring strings.Cut.
+func (c *Conv) Cut(sep string) (before, after string, found bool) {
+	s := c.String()
+	for i := 0; i+len(sep) <= len(s); i++ {
+		if s[i:i+len(sep)] == sep {
+			return s[:i], s[i+len(sep):], true
+		}
+	}
+	return s, "", false
+}
@@ -0,0 +1,65 @@
+package fmt
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSplitAndTrim(t *testing.T) {
+	got := Convert(" apple , , banana ,cherry ").SplitAndTrim(",")
+	want := []string{"apple", "banana", "cherry"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %q want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFields(t *testing.T) {
+	got := Convert("  hello   world  \tfoo\n").Fields()
+	want := []string{"hello", "world", "foo"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("index %d: got %q want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCut(t *testing.T) {
+	before, after, found := Convert("key:value").Cut(":")
+	if !found || before != "key" || after != "value" {
+		t.Errorf("got (%q, %q, %v)", before, after, found)
+	}
+
+	_, _, found = Convert("novalue").Cut(":")
+	if found {
+		t.Error("expected found=false when sep is absent")
+	}
+}
+
+func TestConcurrentFields(t *testing.T) {
+	const n = 100
+	var wg sync.WaitGroup
+	wg.Add(n)
+	var counter safeCounter
+
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			if got := Convert("a b  c").Fields(); len(got) != 3 {
+				counter.addError("unexpected Fields result")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if counter.count > 0 {
+		t.Errorf("Failed with %d errors:\n%s", counter.count, Convert(counter.errs).Join("\n").String())
+	}
+}
@@ -0,0 +1,216 @@
+package fmt
+
+import (
+	"strconv"
+	"sync"
+)
+
+// fastScratchSize is the on-stack/pooled buffer size the zero-alloc Sprintf
+// fast path writes into. Formats whose rendered output doesn't fit fall
+// back to the general Sprintf path.
+const fastScratchSize = 64
+
+var fastScratchPool = sync.Pool{
+	New: func() any { return new([fastScratchSize]byte) },
+}
+
+// fastVerbsOnly reports whether format uses only the verbs the fast path
+// supports (%s, %d, %v, %q, %f) plus %%, so sprintfFast is safe to try.
+func fastVerbsOnly(format string) bool {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 >= len(format) {
+			continue
+		}
+		switch format[i+1] {
+		case 's', 'd', 'v', 'q', 'f', '%':
+			i++
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Sprintf formats format against args using the %s/%d/%v/%q/%f/%% verb set
+// this module's other Sprintf-style call sites already rely on (Translate's
+// positional substitution, Msg's modifiers, log.go's KeyValue). It tries
+// sprintfFast's pooled zero-alloc scratch buffer first; once the rendered
+// output would overflow fastScratchSize, or an arg isn't one of the fast
+// path's special-cased concrete types, it falls back to sprintfGeneral's
+// growable (allocating, but size-unbounded) buffer instead.
+func Sprintf(format string, args ...any) string {
+	if s, ok := sprintfFast(format, args...); ok {
+		return s
+	}
+	return sprintfGeneral(format, args...)
+}
+
+// sprintfGeneral is Sprintf's fallback: the same verb grammar as
+// appendFastVerb, but appending into a growable buffer instead of a fixed
+// scratch buffer, and covering the wider set of concrete types this
+// module's "%v"-style callers actually pass.
+func sprintfGeneral(format string, args ...any) string {
+	buf := make([]byte, 0, len(format)+16)
+	argIdx := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 >= len(format) {
+			buf = append(buf, format[i])
+			continue
+		}
+		verb := format[i+1]
+		i++
+		if verb == '%' {
+			buf = append(buf, '%')
+			continue
+		}
+		var arg any
+		if argIdx < len(args) {
+			arg = args[argIdx]
+			argIdx++
+		}
+		buf = appendGeneralVerb(buf, verb, arg)
+	}
+	return string(buf)
+}
+
+// appendGeneralVerb tries appendFastVerb's narrow type set first (so the
+// two paths render identically for the types they share), then widens to
+// the rest of the concrete types this module's formatting call sites pass.
+func appendGeneralVerb(buf []byte, verb byte, arg any) []byte {
+	if v, ok := appendFastVerb(buf, verb, arg); ok {
+		return v
+	}
+	if verb == 'q' {
+		return strconv.AppendQuote(buf, generalString(arg))
+	}
+	return append(buf, generalString(arg)...)
+}
+
+// generalString renders arg the way "%v" formats any supported concrete
+// type this module's Sprintf callers pass -- the wider type coverage
+// appendFastVerb's narrower %s/%d/%v cases don't special-case.
+func generalString(arg any) string {
+	switch v := arg.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case error:
+		return v.Error()
+	case interface{ String() string }:
+		return v.String()
+	case int:
+		return strconv.FormatInt(int64(v), 10)
+	case int8:
+		return strconv.FormatInt(int64(v), 10)
+	case int16:
+		return strconv.FormatInt(int64(v), 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return "<nil>"
+	default:
+		return "%!(UNSUPPORTED TYPE)"
+	}
+}
+
+// sprintfFast renders format(args...) into a pooled 64-byte scratch buffer
+// using strconv.Append* directly, avoiding the general formatter's
+// allocations. It returns ("", false) when the format uses verbs outside
+// {%s,%d,%v,%q,%f} or the rendered output overflows the scratch buffer;
+// Sprintf falls back to sprintfGeneral in that case.
+func sprintfFast(format string, args ...any) (string, bool) {
+	if !fastVerbsOnly(format) {
+		return "", false
+	}
+
+	scratch := fastScratchPool.Get().(*[fastScratchSize]byte)
+	defer fastScratchPool.Put(scratch)
+	buf := scratch[:0]
+
+	argIdx := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 >= len(format) {
+			buf = append(buf, format[i])
+			continue
+		}
+
+		verb := format[i+1]
+		i++
+		if verb == '%' {
+			buf = append(buf, '%')
+			continue
+		}
+		if argIdx >= len(args) {
+			return "", false
+		}
+		arg := args[argIdx]
+		argIdx++
+
+		var ok bool
+		buf, ok = appendFastVerb(buf, verb, arg)
+		if !ok {
+			return "", false
+		}
+		if len(buf) > fastScratchSize {
+			return "", false
+		}
+	}
+
+	return string(buf), true
+}
+
+func appendFastVerb(buf []byte, verb byte, arg any) ([]byte, bool) {
+	switch verb {
+	case 's':
+		if s, ok := arg.(string); ok {
+			return append(buf, s...), true
+		}
+		return buf, false
+	case 'd':
+		switch v := arg.(type) {
+		case int:
+			return strconv.AppendInt(buf, int64(v), 10), true
+		case int64:
+			return strconv.AppendInt(buf, v, 10), true
+		}
+		return buf, false
+	case 'f':
+		if v, ok := arg.(float64); ok {
+			return strconv.AppendFloat(buf, v, 'f', -1, 64), true
+		}
+		return buf, false
+	case 'q':
+		if s, ok := arg.(string); ok {
+			return strconv.AppendQuote(buf, s), true
+		}
+		return buf, false
+	case 'v':
+		switch v := arg.(type) {
+		case string:
+			return append(buf, v...), true
+		case int:
+			return strconv.AppendInt(buf, int64(v), 10), true
+		}
+		return buf, false
+	}
+	return buf, false
+}
@@ -0,0 +1,225 @@
+package fmt
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"unicode/utf8"
+)
+
+// defaultStreamChunkSize is the read chunk size StreamChain uses when the
+// caller hasn't overridden it via WithChunkSize.
+const defaultStreamChunkSize = 64 * 1024
+
+// Op is one operator in a StreamChain pipeline -- any (*Conv) method with
+// this signature (Tilde, CamelLow, Capitalize, ...) can be queued.
+type Op func(*Conv) *Conv
+
+// StreamChain applies a queued sequence of Conv operators to an io.Reader
+// in fixed-size chunks, without buffering the whole input. It carries a
+// small tail of unconsumed bytes between chunks so a chunk boundary never
+// splits a multi-byte rune or a word the neighbour-aware ops (Tilde,
+// CamelUp, Capitalize, SnakeLow) need to look across.
+type StreamChain struct {
+	r            io.Reader
+	ops          []Op
+	chunkSize    int
+	ctx          context.Context
+	maxTokenSize int
+}
+
+// NewTransformer wraps r so reading from the result applies ops to each
+// chunk, modelled on the wrapping readers in testing/iotest. The chain
+// runs in a background goroutine over an io.Pipe, so a Read call only
+// ever sees as much transformed output as the pipe hands it -- memory use
+// stays bounded by the chain's own chunk size rather than requiring the
+// whole transformed output to be buffered before the first byte is
+// returned.
+func NewTransformer(r io.Reader, ops ...Op) io.Reader {
+	chain := &StreamChain{r: r, ops: ops, chunkSize: defaultStreamChunkSize}
+	pr, pw := io.Pipe()
+	go func() {
+		_, err := chain.WriteTo(pw)
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+// ConvertReader starts a StreamChain over r. Chain operators (Tilde,
+// CamelLow, ...) queue work; WriteTo drives the reader and applies them.
+func ConvertReader(r io.Reader) *StreamChain {
+	return &StreamChain{r: r, chunkSize: defaultStreamChunkSize}
+}
+
+// ConvertReaderCtx starts a StreamChain that processes r line-by-line
+// (via a bufio.Scanner) instead of in fixed-size byte chunks, checking
+// ctx.Done() between lines so a long-running rewrite of a large stream
+// -- e.g. filtering a multi-megabyte `go test` log through PathShort --
+// can be cancelled. Use WithMaxTokenSize to raise the scanner's default
+// token limit for streams with very long lines.
+func ConvertReaderCtx(ctx context.Context, r io.Reader) *StreamChain {
+	return &StreamChain{ctx: ctx, r: r, chunkSize: defaultStreamChunkSize, maxTokenSize: bufio.MaxScanTokenSize}
+}
+
+// WithChunkSize overrides the read chunk size (default 64KiB).
+func (s *StreamChain) WithChunkSize(n int) *StreamChain {
+	s.chunkSize = n
+	return s
+}
+
+// WithMaxTokenSize overrides the bufio.Scanner buffer limit used by a
+// ConvertReaderCtx chain (default bufio.MaxScanTokenSize). It has no
+// effect on a chain started with ConvertReader.
+func (s *StreamChain) WithMaxTokenSize(n int) *StreamChain {
+	s.maxTokenSize = n
+	return s
+}
+
+func (s *StreamChain) push(op Op) *StreamChain {
+	s.ops = append(s.ops, op)
+	return s
+}
+
+func (s *StreamChain) Tilde() *StreamChain      { return s.push((*Conv).Tilde) }
+func (s *StreamChain) CamelLow() *StreamChain   { return s.push((*Conv).CamelLow) }
+func (s *StreamChain) CamelUp() *StreamChain    { return s.push((*Conv).CamelUp) }
+func (s *StreamChain) SnakeLow() *StreamChain   { return s.push((*Conv).SnakeLow) }
+func (s *StreamChain) Capitalize() *StreamChain { return s.push((*Conv).Capitalize) }
+func (s *StreamChain) ToUpper() *StreamChain    { return s.push((*Conv).ToUpper) }
+func (s *StreamChain) ToLower() *StreamChain    { return s.push((*Conv).ToLower) }
+func (s *StreamChain) PathShort() *StreamChain  { return s.push((*Conv).PathShort) }
+
+// lastSafeCut returns the largest prefix length of data that ends on a
+// complete rune and, unless atEOF, on a whitespace/word boundary -- the
+// remainder is carried into the next chunk so word-boundary-aware ops see
+// it attached to their following chunk instead of split mid-word.
+func lastSafeCut(data []byte, atEOF bool) int {
+	if atEOF {
+		return len(data)
+	}
+
+	cut := len(data)
+	for cut > 0 {
+		r, size := utf8.DecodeLastRune(data[:cut])
+		if r == utf8.RuneError && size <= 1 {
+			cut--
+			continue
+		}
+		break
+	}
+
+	// Back off to the last word boundary so we don't hand a partial word to
+	// the next chunk's neighbour-aware ops. This must run even when cut ==
+	// len(data) (the common case, chunk boundary already lands on a
+	// complete rune) -- gating it on cut < len(data) skipped it entirely
+	// then, so words only carried correctly when the chunk also happened
+	// to end mid-rune.
+	for cut > 0 {
+		r, _ := utf8.DecodeLastRune(data[:cut])
+		if r == ' ' || r == '\n' || r == '\t' {
+			break
+		}
+		prevCut := cut
+		_, size := utf8.DecodeLastRune(data[:cut])
+		cut -= size
+		if cut == 0 {
+			cut = prevCut
+			break
+		}
+	}
+	return cut
+}
+
+// WriteTo drives r, applying every queued op and writing the result to w.
+// A chain started with ConvertReaderCtx processes r line-by-line via a
+// bufio.Scanner, checking ctx.Done() between lines so the caller can
+// cancel a long-running rewrite; otherwise r is read in fixed-size chunks
+// as usual. It returns the total bytes written and the first error
+// encountered.
+func (s *StreamChain) WriteTo(w io.Writer) (int64, error) {
+	if s.ctx != nil {
+		return s.writeToLines(w)
+	}
+
+	buf := make([]byte, s.chunkSize)
+	var carry []byte
+	var total int64
+
+	for {
+		n, readErr := s.r.Read(buf)
+		atEOF := readErr == io.EOF
+
+		if n > 0 {
+			data := append(carry, buf[:n]...)
+			cut := lastSafeCut(data, atEOF && n == 0)
+
+			chunk, tail := data[:cut], data[cut:]
+			carry = append(carry[:0], tail...)
+
+			out := Convert(string(chunk))
+			for _, op := range s.ops {
+				out = op(out)
+			}
+			written, err := w.Write([]byte(out.String()))
+			total += int64(written)
+			if err != nil {
+				return total, err
+			}
+		}
+
+		if readErr != nil {
+			if atEOF {
+				if len(carry) > 0 {
+					out := Convert(string(carry))
+					for _, op := range s.ops {
+						out = op(out)
+					}
+					written, err := w.Write([]byte(out.String()))
+					total += int64(written)
+					if err != nil {
+						return total, err
+					}
+				}
+				return total, nil
+			}
+			return total, readErr
+		}
+	}
+}
+
+// writeToLines drives r one line at a time via a bufio.Scanner, applying
+// every queued op to each line and writing the result (plus a trailing
+// newline, mirroring the line boundaries Scan consumed) to w. It checks
+// ctx.Done() before each line so a cancellation lands promptly instead of
+// waiting for the whole stream to drain.
+func (s *StreamChain) writeToLines(w io.Writer) (int64, error) {
+	scanner := bufio.NewScanner(s.r)
+	if s.maxTokenSize > 0 {
+		scanner.Buffer(make([]byte, 0, defaultStreamChunkSize), s.maxTokenSize)
+	}
+
+	var total int64
+	for scanner.Scan() {
+		select {
+		case <-s.ctx.Done():
+			return total, s.ctx.Err()
+		default:
+		}
+
+		out := Convert(scanner.Text())
+		for _, op := range s.ops {
+			out = op(out)
+		}
+
+		written, err := w.Write([]byte(out.String() + "\n"))
+		total += int64(written)
+		if err != nil {
+			return total, err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return total, err
+	}
+	return total, nil
+}
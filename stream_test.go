@@ -0,0 +1,79 @@
+package fmt
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestStreamChainWriteTo(t *testing.T) {
+	src := strings.NewReader("Él Múrcielago Rápido")
+
+	var out strings.Builder
+	n, err := ConvertReader(src).WithChunkSize(8).Tilde().CamelLow().WriteTo(&out)
+	if err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if n != int64(out.Len()) {
+		t.Errorf("reported %d bytes, wrote %d", n, out.Len())
+	}
+
+	want := Convert("Él Múrcielago Rápido").Tilde().CamelLow().String()
+	if out.String() != want {
+		t.Errorf("got %q want %q", out.String(), want)
+	}
+}
+
+func TestConvertReaderCtxPathShort(t *testing.T) {
+	originalBase := pathBase
+	defer func() { pathBase = originalBase }()
+	SetPathBase("/home/user/project")
+
+	src := strings.NewReader("building /home/user/project/main.go\nlinking /home/user/project/out\n")
+
+	var out strings.Builder
+	n, err := ConvertReaderCtx(context.Background(), src).PathShort().WriteTo(&out)
+	if err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if n != int64(out.Len()) {
+		t.Errorf("reported %d bytes, wrote %d", n, out.Len())
+	}
+
+	want := "building ./main.go\nlinking ./out\n"
+	if out.String() != want {
+		t.Errorf("got %q want %q", out.String(), want)
+	}
+}
+
+func TestConvertReaderCtxCancellation(t *testing.T) {
+	src := strings.NewReader("line one\nline two\nline three\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var out strings.Builder
+	_, err := ConvertReaderCtx(ctx, src).ToUpper().WriteTo(&out)
+	if err != context.Canceled {
+		t.Fatalf("want context.Canceled, got %v", err)
+	}
+}
+
+func TestNewTransformer(t *testing.T) {
+	src := strings.NewReader("hello world")
+	r := NewTransformer(src, (*Conv).ToUpper)
+
+	// NewTransformer streams through an io.Pipe now, so the transformed
+	// output can arrive across several Read calls instead of all at once --
+	// drain it fully rather than assuming a single Read sees everything.
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	want := Convert("hello world").ToUpper().String()
+	if string(got) != want {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
@@ -0,0 +1,142 @@
+package fmt
+
+import (
+	"strconv"
+	"strings"
+)
+
+// StructTag is a struct tag parsed per reflect.StructTag's own grammar: a
+// key (a run of non-space, non-quote, non-colon bytes), a ':' delimiter,
+// then a double-quoted Go string literal value, with one or more spaces
+// separating tags. Unlike TagValue/TagPairs, parsing does not recover
+// leniently: a malformed tag and every tag after it are absent (Lookup
+// reports false), while tags parsed before it are kept.
+type StructTag struct {
+	pairs []structTagPair
+}
+
+type structTagPair struct {
+	key   string
+	value string
+}
+
+// StructTag parses c's string as a reflect.StructTag-conformant tag,
+// tokenizing exactly like reflect.StructTag.Lookup. Malformed input (an
+// unterminated quote, a key containing a quote, a missing ':') stops
+// parsing at the point of failure and returns whatever tags were
+// successfully parsed before it, mirroring reflect.StructTag's own
+// best-effort behavior when scanning past a bad tag.
+func (c *Conv) StructTag() StructTag {
+	if len(c.err) > 0 {
+		return StructTag{}
+	}
+	return parseStructTag(c.String())
+}
+
+// parseStructTag implements the same loop as reflect.StructTag.Lookup,
+// collecting every key/value pair instead of stopping at the first match.
+func parseStructTag(tag string) StructTag {
+	var st StructTag
+
+	for tag != "" {
+		// Skip leading space (one or more, per the struct tag grammar).
+		i := 0
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+		tag = tag[i:]
+		if tag == "" {
+			break
+		}
+
+		// Scan to colon. A space, quote, or control character in the key
+		// ends the tag list early, matching reflect.StructTag.
+		i = 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			break
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		// Scan quoted string to find value, honoring backslash escapes.
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			break
+		}
+		quoted := tag[:i+1]
+		tag = tag[i+1:]
+
+		value, err := strconv.Unquote(quoted)
+		if err != nil {
+			break
+		}
+
+		st.pairs = append(st.pairs, structTagPair{key: key, value: value})
+	}
+
+	return st
+}
+
+// Lookup returns the value associated with key in the tag, and whether key
+// was present at all (mirroring reflect.StructTag.Lookup's value/ok pair,
+// as opposed to Get's empty-string-means-absent ambiguity).
+func (st StructTag) Lookup(key string) (string, bool) {
+	for _, p := range st.pairs {
+		if p.key == key {
+			return p.value, true
+		}
+	}
+	return "", false
+}
+
+// Get returns the value associated with key, or "" if key is absent or its
+// value is the empty string -- use Lookup to tell those two cases apart.
+func (st StructTag) Get(key string) string {
+	v, _ := st.Lookup(key)
+	return v
+}
+
+// Keys returns every key registered in the tag, in the order they appear.
+func (st StructTag) Keys() []string {
+	keys := make([]string, len(st.pairs))
+	for i, p := range st.pairs {
+		keys[i] = p.key
+	}
+	return keys
+}
+
+// Options splits key's value on ',' into KeyValue pairs, the same comma
+// grammar TagPairs assumes: a bareword option (e.g. "omitempty") becomes a
+// KeyValue with an empty Value, while "k=v" or "k:v" splits into Key/Value.
+func (st StructTag) Options(key string) []KeyValue {
+	value, ok := st.Lookup(key)
+	if !ok || value == "" {
+		return nil
+	}
+
+	var out []KeyValue
+	for _, part := range strings.Split(value, ",") {
+		if part == "" {
+			continue
+		}
+		if k, v, found := strings.Cut(part, "="); found {
+			out = append(out, KeyValue{Key: k, Value: v})
+			continue
+		}
+		if k, v, found := strings.Cut(part, ":"); found {
+			out = append(out, KeyValue{Key: k, Value: v})
+			continue
+		}
+		out = append(out, KeyValue{Key: part})
+	}
+	return out
+}
@@ -0,0 +1,130 @@
+package fmt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStructTagLookup(t *testing.T) {
+	tag := Convert(`json:"user_name,omitempty" validate:"required,min=3" db:"username"`).StructTag()
+
+	tests := []struct {
+		key       string
+		wantValue string
+		wantOK    bool
+	}{
+		{"json", "user_name,omitempty", true},
+		{"validate", "required,min=3", true},
+		{"db", "username", true},
+		{"xml", "", false},
+	}
+
+	for _, tt := range tests {
+		v, ok := tag.Lookup(tt.key)
+		if v != tt.wantValue || ok != tt.wantOK {
+			t.Errorf("Lookup(%q) = (%q, %v), want (%q, %v)", tt.key, v, ok, tt.wantValue, tt.wantOK)
+		}
+	}
+
+	if got := tag.Get("xml"); got != "" {
+		t.Errorf("Get(missing) = %q, want \"\"", got)
+	}
+
+	wantKeys := []string{"json", "validate", "db"}
+	if got := tag.Keys(); !reflect.DeepEqual(got, wantKeys) {
+		t.Errorf("Keys() = %v, want %v", got, wantKeys)
+	}
+}
+
+func TestStructTagEscapedQuotes(t *testing.T) {
+	tag := Convert(`key:"a\"b"`).StructTag()
+	got, ok := tag.Lookup("key")
+	if !ok || got != `a"b` {
+		t.Errorf(`Lookup("key") = (%q, %v), want ("a\"b", true)`, got, ok)
+	}
+}
+
+func TestStructTagEmbeddedSpace(t *testing.T) {
+	tag := Convert(`label:"Full Name" json:"name"`).StructTag()
+	if got, ok := tag.Lookup("label"); !ok || got != "Full Name" {
+		t.Errorf(`Lookup("label") = (%q, %v), want ("Full Name", true)`, got, ok)
+	}
+	if got, ok := tag.Lookup("json"); !ok || got != "name" {
+		t.Errorf(`Lookup("json") = (%q, %v), want ("name", true)`, got, ok)
+	}
+}
+
+func TestStructTagEmptyValue(t *testing.T) {
+	tag := Convert(`json:""`).StructTag()
+	got, ok := tag.Lookup("json")
+	if !ok || got != "" {
+		t.Errorf(`Lookup("json") = (%q, %v), want ("", true)`, got, ok)
+	}
+}
+
+func TestStructTagMalformed(t *testing.T) {
+	tests := []string{
+		`json:name`,          // unquoted value, not a valid StructTag per reflect's grammar
+		`json:"unterminated`, // missing closing quote
+		`json`,               // missing colon entirely
+		``,                   // empty
+	}
+
+	for _, in := range tests {
+		tag := Convert(in).StructTag()
+		if keys := tag.Keys(); len(keys) != 0 {
+			t.Errorf("StructTag(%q).Keys() = %v, want none", in, keys)
+		}
+	}
+}
+
+func TestStructTagOptions(t *testing.T) {
+	tag := Convert(`validate:"required,min=3,max:10" json:"name,omitempty"`).StructTag()
+
+	got := tag.Options("validate")
+	want := []KeyValue{{Key: "required"}, {Key: "min", Value: "3"}, {Key: "max", Value: "10"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Options(validate) = %v, want %v", got, want)
+	}
+
+	got = tag.Options("json")
+	want = []KeyValue{{Key: "name"}, {Key: "omitempty"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Options(json) = %v, want %v", got, want)
+	}
+
+	if got := tag.Options("missing"); got != nil {
+		t.Errorf("Options(missing) = %v, want nil", got)
+	}
+}
+
+// FuzzStructTag asserts StructTag never panics on arbitrary input and that
+// every key Lookup reports present also appears in Keys(), covering the
+// adversarial cases the spec-conformant parser must survive: escaped
+// quotes, embedded spaces, and empty values.
+func FuzzStructTag(f *testing.F) {
+	seeds := []string{
+		`json:"user_name,omitempty" validate:"required,min=3" db:"username"`,
+		`key:"a\"b"`,
+		`label:"Full Name" json:"name"`,
+		`json:""`,
+		`json:name`,
+		`json:"unterminated`,
+		``,
+		`   `,
+		`key:"value\\"`,
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		tag := Convert(s).StructTag()
+		keys := tag.Keys()
+		for _, k := range keys {
+			if _, ok := tag.Lookup(k); !ok {
+				t.Fatalf("Keys() returned %q but Lookup(%q) reported not found", k, k)
+			}
+		}
+	})
+}
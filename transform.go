@@ -0,0 +1,154 @@
+package fmt
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrShortDst and ErrShortSrc are local equivalents of
+// golang.org/x/text/transform's sentinel errors of the same name, so a
+// Transformer can report the same two conditions that package's pipelines
+// already know how to retry without this module importing it: ErrShortDst
+// means dst can't hold the result of processing src (e.g. German ß -> "SS"
+// doubles the length) and the caller should retry with a bigger dst;
+// ErrShortSrc means src's tail may be an incomplete rune and the caller
+// should retry once more bytes are available.
+var (
+	ErrShortDst = errors.New("tinystring: dst too small for transform result")
+	ErrShortSrc = errors.New("tinystring: src ends in an incomplete rune")
+)
+
+// Transformer adapts a queued Op chain (see StreamChain's Op type) to the
+// three-argument Transform shape golang.org/x/text/transform.Transformer
+// expects, so a Conv chain -- Tilde().ToLower().Replace(...) -- can sit in
+// an x/text pipeline or drive io.Copy through NewTransformWriter without
+// buffering the whole input.
+type Transformer struct {
+	ops   []Op
+	carry []byte
+}
+
+// NewConvTransformer builds a Transformer applying ops, in order, to each
+// chunk Transform processes.
+func NewConvTransformer(ops ...Op) *Transformer {
+	return &Transformer{ops: ops}
+}
+
+// Reset clears any carried partial-rune bytes, so t can be reused from a
+// clean state on a new input.
+func (t *Transformer) Reset() { t.carry = nil }
+
+// lastRuneBoundary returns the largest prefix length of data that ends on
+// a complete rune; with atEOF it returns len(data) unconditionally so a
+// trailing malformed tail is still flushed rather than silently dropped.
+func lastRuneBoundary(data []byte, atEOF bool) int {
+	if atEOF {
+		return len(data)
+	}
+	cut := len(data)
+	for cut > 0 {
+		r, size := utf8.DecodeLastRune(data[:cut])
+		if r == utf8.RuneError && size <= 1 {
+			cut--
+			continue
+		}
+		break
+	}
+	return cut
+}
+
+// Transform applies t's ops to as much of src as forms complete runes,
+// writing the result to dst. It carries any trailing incomplete rune
+// internally between calls (up to utf8.UTFMax bytes) so a chunk boundary
+// from an io.Reader never splits a multi-byte rune across two Transform
+// calls. Callers must pass unconsumed src back unchanged on ErrShortSrc,
+// and the same src (with a larger dst) on ErrShortDst, matching
+// transform.Transformer's contract.
+func (t *Transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	data := src
+	carryLen := len(t.carry)
+	if carryLen > 0 {
+		data = append(append([]byte(nil), t.carry...), src...)
+	}
+
+	cut := lastRuneBoundary(data, atEOF)
+	if cut <= carryLen {
+		if !atEOF {
+			t.carry = data
+			return 0, len(src), ErrShortSrc
+		}
+		cut = len(data)
+	}
+
+	chunk := data[:cut]
+	out := Convert(string(chunk))
+	for _, op := range t.ops {
+		out = op(out)
+	}
+	result := []byte(out.String())
+	if len(result) > len(dst) {
+		return 0, 0, ErrShortDst
+	}
+
+	copy(dst, result)
+	t.carry = append([]byte(nil), data[cut:]...)
+	return len(result), cut - carryLen, nil
+}
+
+// Reader returns an io.Reader over c's current content (e.g. the result of
+// Convert(s).Tilde().ToLower()), so a built Conv chain can feed io.Copy or
+// any other io.Reader-consuming pipeline without an intermediate String()
+// allocation at the call site.
+func (c *Conv) Reader() io.Reader {
+	return strings.NewReader(c.String())
+}
+
+// transformWriter adapts a Transformer into an io.Writer: each Write call
+// runs Transform with atEOF=false, growing its scratch dst buffer past any
+// ErrShortDst and relying on Transformer's internal carry to absorb a
+// trailing partial rune until the next Write supplies the rest.
+//
+// Because Write never sees atEOF, a write whose very last bytes end
+// mid-rune leaves that tail in the Transformer uncommitted -- a stream
+// that is itself malformed UTF-8 at EOF loses its final incomplete rune
+// rather than emitting it raw. Well-formed UTF-8 input is unaffected.
+type transformWriter struct {
+	dst io.Writer
+	t   *Transformer
+}
+
+// NewTransformWriter returns an io.Writer that applies ops, in order, to
+// every byte written through it before forwarding the result to dst --
+// the Writer-side counterpart of NewTransformer's Reader wrapping, usable
+// as io.Copy(NewTransformWriter(dst, (*Conv).Tilde, (*Conv).ToLower), src)
+// to rewrite a large stream (log redaction, CSV normalization) without
+// buffering it whole.
+func NewTransformWriter(dst io.Writer, ops ...Op) io.Writer {
+	return &transformWriter{dst: dst, t: NewConvTransformer(ops...)}
+}
+
+func (w *transformWriter) Write(p []byte) (int, error) {
+	src := p
+	for len(src) > 0 {
+		dstBuf := make([]byte, 4096)
+		var nDst, nSrc int
+		for {
+			var terr error
+			nDst, nSrc, terr = w.t.Transform(dstBuf, src, false)
+			if terr == ErrShortDst {
+				dstBuf = make([]byte, len(dstBuf)*2)
+				continue
+			}
+			break
+		}
+		if nDst > 0 {
+			if _, err := w.dst.Write(dstBuf[:nDst]); err != nil {
+				return len(p) - len(src), err
+			}
+		}
+		src = src[nSrc:]
+	}
+	return len(p), nil
+}
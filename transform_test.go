@@ -0,0 +1,124 @@
+package fmt
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestTransformerAppliesOps(t *testing.T) {
+	tr := NewConvTransformer((*Conv).ToLower)
+	dst := make([]byte, 64)
+
+	nDst, nSrc, err := tr.Transform(dst, []byte("HELLO WORLD"), true)
+	if err != nil {
+		t.Fatalf("Transform: %v", err)
+	}
+	if nSrc != len("HELLO WORLD") {
+		t.Errorf("nSrc = %d, want %d", nSrc, len("HELLO WORLD"))
+	}
+	if got := string(dst[:nDst]); got != "hello world" {
+		t.Errorf("got %q want %q", got, "hello world")
+	}
+}
+
+func TestTransformerCarriesPartialRuneAcrossCalls(t *testing.T) {
+	tr := NewConvTransformer((*Conv).ToUpper)
+	// "é" encodes as the two bytes 0xC3 0xA9; split between them so the
+	// first Transform call sees a lone lead byte it can't decode yet.
+	lead, cont := byte(0xC3), byte(0xA9)
+
+	dst := make([]byte, 64)
+	nDst, nSrc, err := tr.Transform(dst, []byte{lead}, false)
+	if err != ErrShortSrc {
+		t.Fatalf("want ErrShortSrc, got nDst=%d nSrc=%d err=%v", nDst, nSrc, err)
+	}
+	if nSrc != 1 {
+		t.Errorf("nSrc = %d, want 1 (lead byte absorbed into carry)", nSrc)
+	}
+
+	nDst, nSrc, err = tr.Transform(dst, []byte{cont}, true)
+	if err != nil {
+		t.Fatalf("Transform final: %v", err)
+	}
+	if nSrc != 1 {
+		t.Errorf("nSrc = %d, want 1", nSrc)
+	}
+	if got := string(dst[:nDst]); got != strings.ToUpper("é") {
+		t.Errorf("got %q want %q", got, strings.ToUpper("é"))
+	}
+}
+
+func TestTransformerShortDst(t *testing.T) {
+	tr := NewConvTransformer((*Conv).ToUpper)
+	dst := make([]byte, 2)
+
+	_, _, err := tr.Transform(dst, []byte("hello"), true)
+	if err != ErrShortDst {
+		t.Fatalf("want ErrShortDst, got %v", err)
+	}
+}
+
+func TestConvReader(t *testing.T) {
+	r := Convert("Él Múrcielago").Tilde().Reader()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	want := Convert("Él Múrcielago").Tilde().String()
+	if string(data) != want {
+		t.Errorf("got %q want %q", data, want)
+	}
+}
+
+func TestNewTransformWriter(t *testing.T) {
+	var out strings.Builder
+	w := NewTransformWriter(&out, (*Conv).ToLower)
+
+	src := strings.NewReader("HELLO, José, WORLD")
+	if _, err := io.Copy(w, src); err != nil {
+		t.Fatalf("io.Copy: %v", err)
+	}
+
+	want := Convert("HELLO, José, WORLD").ToLower().String()
+	if out.String() != want {
+		t.Errorf("got %q want %q", out.String(), want)
+	}
+}
+
+func TestNewTransformWriterAcrossSmallWrites(t *testing.T) {
+	var out strings.Builder
+	w := NewTransformWriter(&out, (*Conv).ToUpper)
+
+	input := "café au lait"
+	for i := 0; i < len(input); i++ {
+		if _, err := w.Write([]byte{input[i]}); err != nil {
+			t.Fatalf("Write byte %d: %v", i, err)
+		}
+	}
+
+	want := Convert(input).ToUpper().String()
+	if out.String() != want {
+		t.Errorf("got %q want %q", out.String(), want)
+	}
+}
+
+func BenchmarkTransformWriterVsConvertString(b *testing.B) {
+	huge := strings.Repeat("The Quick Brown José Fox ", 10000)
+
+	b.Run("Convert.ToLower.String", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			out := Convert(huge).ToLower().String()
+			_ = out
+		}
+	})
+
+	b.Run("NewTransformWriter", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			w := NewTransformWriter(io.Discard, (*Conv).ToLower)
+			_, _ = io.Copy(w, strings.NewReader(huge))
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package fmt
+
+import (
+	"context"
+	"io/fs"
+)
+
+// Translator resolves a word for a given language code, independent of the
+// process-global registry RegisterWords writes to. Lookup reports whether
+// key was found so callers can apply their own fallback chain.
+type Translator interface {
+	Lookup(lang, key string) (string, bool)
+}
+
+type ctxKey struct{ name string }
+
+var (
+	translatorCtxKey = ctxKey{"translator"}
+	langCtxKey       = ctxKey{"lang"}
+)
+
+// WithTranslator returns a context carrying t, consulted by TranslateCtx
+// instead of the global RegisterWords map.
+func WithTranslator(ctx context.Context, t Translator) context.Context {
+	return context.WithValue(ctx, translatorCtxKey, t)
+}
+
+// WithLang returns a context carrying the active language code, consulted
+// by TranslateCtx instead of the process-global OutLang.
+func WithLang(ctx context.Context, langCode string) context.Context {
+	return context.WithValue(ctx, langCtxKey, langCode)
+}
+
+// TranslateCtx behaves like Translate, but resolves its language and
+// Translator from ctx, so concurrent requests in different locales don't
+// need to mutate (or read) process-global state.
+func TranslateCtx(ctx context.Context, args ...any) *Conv {
+	langCode, _ := ctx.Value(langCtxKey).(string)
+	if langCode == "" {
+		langCode = getCurrentLang().String()
+	}
+
+	tr, _ := ctx.Value(translatorCtxKey).(Translator)
+	if tr == nil {
+		tr = globalTranslator{}
+	}
+
+	resolved := make([]any, len(args))
+	for i, a := range args {
+		if key, ok := a.(string); ok {
+			if word, found := tr.Lookup(langCode, key); found {
+				resolved[i] = word
+				continue
+			}
+		}
+		resolved[i] = a
+	}
+
+	return Translate(resolved...)
+}
+
+// globalTranslator adapts the process-global RegisterWords dictionary to
+// the Translator interface, preserving current behavior for callers that
+// don't install a context Translator.
+type globalTranslator struct{}
+
+func (globalTranslator) Lookup(langCode, key string) (string, bool) {
+	c := GetConv()
+	l := c.langParser(langCode)
+	c.putConv()
+
+	// Translate already falls back to the raw key when unregistered, and the
+	// global dictionary doesn't expose presence separately from Translate, so
+	// "found" means the result differs from key; a word registered as its own
+	// translation reports not found.
+	got := Translate(l, key).String()
+	return got, got != key
+}
+
+// MapTranslator is an in-memory Translator keyed by lang then key, useful
+// for tests that want an isolated dictionary instead of accumulating state
+// across RegisterWords calls.
+type MapTranslator map[string]map[string]string
+
+func (m MapTranslator) Lookup(langCode, key string) (string, bool) {
+	words, ok := m[langCode]
+	if !ok {
+		return "", false
+	}
+	word, ok := words[key]
+	return word, ok
+}
+
+// FSTranslator loads "<lang>.json" flat dictionaries from fsys on every
+// Lookup's first use of a language, caching the parsed result.
+type FSTranslator struct {
+	FS    fs.FS
+	cache map[string]map[string]string
+}
+
+func (f *FSTranslator) Lookup(langCode, key string) (string, bool) {
+	if f.cache == nil {
+		f.cache = map[string]map[string]string{}
+	}
+	words, ok := f.cache[langCode]
+	if !ok {
+		data, err := fs.ReadFile(f.FS, langCode+".json")
+		if err != nil {
+			f.cache[langCode] = nil
+			return "", false
+		}
+		words, _ = parseJSONCatalog(data)
+		f.cache[langCode] = words
+	}
+	word, ok := words[key]
+	return word, ok
+}
+
+// ChainedTranslator walks its Translators in order (e.g. pt_BR -> pt -> en)
+// and returns the first hit.
+type ChainedTranslator struct {
+	Langs       []string
+	Translators []Translator
+}
+
+// NewChainedTranslator builds a ChainedTranslator that tries each of
+// langFallback in order against tr.
+func NewChainedTranslator(tr Translator, langFallback ...string) *ChainedTranslator {
+	return &ChainedTranslator{Langs: langFallback, Translators: []Translator{tr}}
+}
+
+func (c *ChainedTranslator) Lookup(_, key string) (string, bool) {
+	for _, l := range c.Langs {
+		for _, tr := range c.Translators {
+			if word, ok := tr.Lookup(l, key); ok {
+				return word, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,34 @@
+package fmt
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTranslateCtxMapTranslator(t *testing.T) {
+	tr := MapTranslator{
+		"es": {"ctx_hello": "ctx_hola"},
+		"en": {"ctx_hello": "ctx_hello_en"},
+	}
+
+	ctx := WithTranslator(context.Background(), tr)
+	ctx = WithLang(ctx, "es")
+
+	got := TranslateCtx(ctx, "ctx_hello").String()
+	if got != "ctx_hola" {
+		t.Errorf("want %q got %q", "ctx_hola", got)
+	}
+}
+
+func TestChainedTranslatorFallback(t *testing.T) {
+	tr := MapTranslator{
+		"pt": {"ctx_save": "ctx_salvar"},
+	}
+	chain := NewChainedTranslator(tr, "pt_BR", "pt", "en")
+
+	ctx := WithTranslator(context.Background(), chain)
+	got := TranslateCtx(ctx, "ctx_save").String()
+	if got != "ctx_salvar" {
+		t.Errorf("want %q got %q", "ctx_salvar", got)
+	}
+}
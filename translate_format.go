@@ -0,0 +1,174 @@
+package fmt
+
+import (
+	"sync"
+	"time"
+)
+
+// FormatEntry carries the locale-specific patterns Date, Time and Number
+// need for one language: one Go time layout per style, plus substitution
+// tables for month/weekday names, alongside the word translations a
+// DictEntry already provides.
+type FormatEntry struct {
+	Lang string
+
+	// DatePattern/TimePattern map a style ("short", "medium", "long", "full")
+	// to a Go reference-time layout.
+	DatePattern map[string]string
+	TimePattern map[string]string
+
+	// Months/Weekdays substitute for Go's English month/weekday names when
+	// rendering a layout that spells them out (Jan, Monday, ...). Index 0 is
+	// January / Sunday.
+	Months   [12]string
+	Weekdays [7]string
+}
+
+var (
+	formatEntriesMu sync.RWMutex
+	formatEntries   = map[lang]*FormatEntry{}
+)
+
+func init() {
+	RegisterFormats(
+		FormatEntry{
+			Lang: "en",
+			DatePattern: map[string]string{
+				"short":  "1/2/06",
+				"medium": "Jan 2, 2006",
+				"long":   "January 2, 2006",
+				"full":   "Monday, January 2, 2006",
+			},
+			TimePattern: map[string]string{
+				"short":  "3:04 PM",
+				"medium": "3:04:05 PM",
+				"long":   "3:04:05 PM MST",
+				"full":   "3:04:05 PM MST",
+			},
+		},
+		FormatEntry{
+			Lang: "de",
+			DatePattern: map[string]string{
+				"short":  "02.01.06",
+				"medium": "02.01.2006",
+				"long":   "2. January 2006",
+				"full":   "Monday, 2. January 2006",
+			},
+			TimePattern: map[string]string{
+				"short":  "15:04",
+				"medium": "15:04:05",
+				"long":   "15:04:05 MST",
+				"full":   "15:04:05 MST",
+			},
+		},
+	)
+}
+
+// RegisterFormats registers (or merges) one or more FormatEntry values,
+// keyed by the language they apply to, mirroring how RegisterWords merges
+// DictEntry values into the word dictionary.
+func RegisterFormats(entries ...FormatEntry) {
+	c := GetConv()
+	defer c.putConv()
+
+	formatEntriesMu.Lock()
+	defer formatEntriesMu.Unlock()
+	for _, e := range entries {
+		l := c.langParser(e.Lang)
+		cp := e
+		formatEntries[l] = &cp
+	}
+}
+
+// SetActiveLanguage sets the language Date/Time/Number (and word
+// translation via Translate/OutLang) resolve against, so callers no longer
+// need to thread a lang parameter through every helper.
+func SetActiveLanguage(code string) string {
+	return OutLang(code)
+}
+
+func formatEntryFor(l lang) *FormatEntry {
+	formatEntriesMu.RLock()
+	e, ok := formatEntries[l]
+	formatEntriesMu.RUnlock()
+	if !ok {
+		formatEntriesMu.RLock()
+		e = formatEntries[EN]
+		formatEntriesMu.RUnlock()
+	}
+	return e
+}
+
+func applyNameSubstitutions(s string, e *FormatEntry) string {
+	if e == nil {
+		return s
+	}
+	englishMonths := [12]string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"}
+	englishWeekdays := [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+	c := Convert(s)
+	for i, name := range englishMonths {
+		if e.Months[i] != "" {
+			c = c.Replace(name, e.Months[i])
+		}
+	}
+	for i, name := range englishWeekdays {
+		if e.Weekdays[i] != "" {
+			c = c.Replace(name, e.Weekdays[i])
+		}
+	}
+	return c.String()
+}
+
+// Date appends t formatted per style ("short", "medium", "long", "full")
+// using the active language's date pattern (see OutLang/SetActiveLanguage),
+// falling back to EN when the active language has no Date pattern.
+func (c *Conv) Date(t time.Time, style string) *Conv {
+	if len(c.err) > 0 {
+		return c
+	}
+	e := formatEntryFor(getCurrentLang())
+	layout, ok := e.DatePattern[style]
+	if !ok {
+		layout = e.DatePattern["medium"]
+	}
+	return c.Write(applyNameSubstitutions(t.Format(layout), e))
+}
+
+// Time appends t formatted per style using the active language's time
+// pattern, falling back to EN when missing.
+func (c *Conv) Time(t time.Time, style string) *Conv {
+	if len(c.err) > 0 {
+		return c
+	}
+	e := formatEntryFor(getCurrentLang())
+	layout, ok := e.TimePattern[style]
+	if !ok {
+		layout = e.TimePattern["medium"]
+	}
+	return c.Write(applyNameSubstitutions(t.Format(layout), e))
+}
+
+// Number appends x formatted per style ("decimal", "percent", "currency")
+// using the active language's grouping conventions: "decimal" via
+// formatNumberLocale with the active language's NumberSymbols (EN included,
+// unlike Thousands()'s legacy EU default), "percent" via formatPercentLocale
+// (locale-aware "%" spacing),
+// and "currency" via formatCurrencyLocale with the active language's
+// default currency symbol (see currencySymbolFor) -- call Currency
+// directly when a specific symbol is needed instead of the locale default.
+func (c *Conv) Number(x float64, style string) *Conv {
+	if len(c.err) > 0 {
+		return c
+	}
+	l := getCurrentLang()
+	switch style {
+	case "percent":
+		return c.Write(formatPercentLocale(x, l))
+	case "currency":
+		return c.Write(formatCurrencyLocale(x, currencySymbolFor(l), l))
+	default:
+		return c.Write(formatNumberLocale(x, numberSymbolsFor(l)))
+	}
+}
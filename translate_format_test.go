@@ -0,0 +1,48 @@
+package fmt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTranslateDateTime(t *testing.T) {
+	ts := time.Date(2026, time.March, 5, 14, 30, 0, 0, time.UTC)
+
+	SetActiveLanguage("en")
+	gotDate := Translate().Date(ts, "medium").String()
+	if gotDate != "Mar 5, 2026" {
+		t.Errorf("Date(en, medium): got %q", gotDate)
+	}
+
+	SetActiveLanguage("de")
+	gotDate = Translate().Date(ts, "medium").String()
+	if gotDate != "05.03.2026" {
+		t.Errorf("Date(de, medium): got %q", gotDate)
+	}
+}
+
+func TestTranslateNumber(t *testing.T) {
+	SetActiveLanguage("en")
+	defer SetActiveLanguage("en")
+
+	got := Translate().Number(0.5, "percent").String()
+	if got != "50%" {
+		t.Errorf("Number(en, percent): got %q", got)
+	}
+
+	got = Translate().Number(1234.56, "currency").String()
+	if got != "$1,234.56" {
+		t.Errorf("Number(en, currency): got %q", got)
+	}
+
+	SetActiveLanguage("fr")
+	got = Translate().Number(0.5, "percent").String()
+	if got != "50 %" {
+		t.Errorf("Number(fr, percent): got %q", got)
+	}
+
+	got = Translate().Number(1234.56, "currency").String()
+	if got != "1 234,56 €" {
+		t.Errorf("Number(fr, currency): got %q", got)
+	}
+}
@@ -0,0 +1,139 @@
+package fmt
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// icuPlural is a compiled plural message: one text template per CLDR
+// category, "#" substituted with the formatted count and "{0}", "{1}", ...
+// substituted with positional args.
+type icuPlural struct {
+	cases map[PluralCategory]string
+}
+
+// icuCompileCache stores one compiled icuPlural per raw catalog string so
+// the hot path (TranslatePlural) never reparses the grammar.
+var icuCompileCache sync.Map // string -> *icuPlural
+
+// compileICUPlural parses either the short two-form grammar ("file|files")
+// or the full ICU grammar ("{n, plural, one {# archivo} other {# archivos}}",
+// or a "{gender, select, male {...} other {...}}" block).
+func compileICUPlural(raw string) *icuPlural {
+	if cached, ok := icuCompileCache.Load(raw); ok {
+		return cached.(*icuPlural)
+	}
+
+	p := &icuPlural{cases: map[PluralCategory]string{}}
+
+	trimmed := strings.TrimSpace(raw)
+	switch {
+	case strings.HasPrefix(trimmed, "{") && (strings.Contains(trimmed, "plural") || strings.Contains(trimmed, "select")):
+		parseICUBlock(trimmed, p)
+	case strings.Contains(raw, "|"):
+		parts := strings.SplitN(raw, "|", 2)
+		p.cases[PluralOne] = parts[0]
+		p.cases[PluralOther] = parts[1]
+	default:
+		p.cases[PluralOther] = raw
+	}
+
+	icuCompileCache.Store(raw, p)
+	return p
+}
+
+// parseICUBlock tokenizes "{name, plural, cat {text} cat {text} ...}".
+func parseICUBlock(s string, p *icuPlural) {
+	// Drop the outer "{name, plural," header and trailing "}".
+	start := strings.Index(s, ",")
+	if start == -1 {
+		return
+	}
+	body := s[start+1:]
+	body = strings.TrimSpace(body)
+	body = strings.TrimPrefix(body, "plural,")
+	body = strings.TrimPrefix(body, "select,")
+	body = strings.TrimSpace(body)
+	body = strings.TrimSuffix(strings.TrimSpace(body), "}")
+
+	for len(body) > 0 {
+		body = strings.TrimSpace(body)
+		if body == "" {
+			break
+		}
+		sp := strings.IndexAny(body, " \t")
+		if sp == -1 {
+			break
+		}
+		category := body[:sp]
+		rest := strings.TrimSpace(body[sp+1:])
+		if !strings.HasPrefix(rest, "{") {
+			break
+		}
+		depth := 0
+		end := -1
+		for i, r := range rest {
+			switch r {
+			case '{':
+				depth++
+			case '}':
+				depth--
+				if depth == 0 {
+					end = i
+				}
+			}
+			if end != -1 {
+				break
+			}
+		}
+		if end == -1 {
+			break
+		}
+		text := rest[1:end]
+		p.cases[PluralCategory(category)] = text
+		body = rest[end+1:]
+	}
+}
+
+// render substitutes "#" with the formatted count and "{0}", "{1}", ... with
+// args, for the text registered under category (falling back to "other").
+func (p *icuPlural) render(category PluralCategory, n int, args ...any) string {
+	text, ok := p.cases[category]
+	if !ok {
+		text = p.cases[PluralOther]
+	}
+
+	text = strings.ReplaceAll(text, "#", strconv.Itoa(n))
+	for i, a := range args {
+		placeholder := "{" + strconv.Itoa(i) + "}"
+		text = strings.ReplaceAll(text, placeholder, Sprintf("%v", a))
+	}
+	return text
+}
+
+// TranslatePlural resolves key through Translate (the RegisterWords
+// dictionary; RegisterMessages' templates are not consulted) and reads the
+// result as an ICU plural grammar -- either the short "a|b"
+// two-form shorthand or a full "{n, plural, one {...} other {...}}" block
+// -- selects the branch matching n's CLDR category for the current output
+// language, and substitutes # and positional args into it.
+func TranslatePlural(key string, n int, args ...any) *Conv {
+	raw := Translate(key).String()
+	plural := compileICUPlural(raw)
+	category := resolvePluralCategory(getCurrentLang(), n)
+	return Convert(plural.render(category, n, args...))
+}
+
+// TranslateSelect resolves key the same way TranslatePlural does, but picks
+// the branch literally named variant (e.g. a gender key) instead of a
+// plural category, falling back to "other".
+func TranslateSelect(key, variant string, args ...any) *Conv {
+	raw := Translate(key).String()
+	plural := compileICUPlural(raw)
+	category := PluralCategory(variant)
+	if _, ok := plural.cases[category]; !ok {
+		category = PluralOther
+	}
+	return Convert(plural.render(category, 0, args...))
+}
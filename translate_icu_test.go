@@ -0,0 +1,51 @@
+package fmt
+
+import "testing"
+
+func TestTranslatePluralShortForm(t *testing.T) {
+	RegisterWords([]DictEntry{
+		{EN: "icu_files", ES: "icu_file|icu_files"},
+	})
+
+	OutLang(ES)
+	got := TranslatePlural("icu_files", 1).String()
+	if got != "icu_file" {
+		t.Errorf("n=1: got %q", got)
+	}
+	got = TranslatePlural("icu_files", 3).String()
+	if got != "icu_files" {
+		t.Errorf("n=3: got %q", got)
+	}
+}
+
+func TestTranslatePluralICUGrammar(t *testing.T) {
+	RegisterWords([]DictEntry{
+		{EN: "icu_archivo", ES: "{n, plural, one {# archivo} other {# archivos}}"},
+	})
+
+	OutLang(ES)
+	got := TranslatePlural("icu_archivo", 1).String()
+	if got != "1 archivo" {
+		t.Errorf("n=1: got %q", got)
+	}
+	got = TranslatePlural("icu_archivo", 5).String()
+	if got != "5 archivos" {
+		t.Errorf("n=5: got %q", got)
+	}
+}
+
+func TestTranslateSelectGender(t *testing.T) {
+	RegisterWords([]DictEntry{
+		{EN: "icu_greeting", ES: "{gender, select, male {Bienvenido} female {Bienvenida} other {Bienvenide}}"},
+	})
+
+	OutLang(ES)
+	got := TranslateSelect("icu_greeting", "female").String()
+	if got != "Bienvenida" {
+		t.Errorf("female: got %q", got)
+	}
+	got = TranslateSelect("icu_greeting", "unknown").String()
+	if got != "Bienvenide" {
+		t.Errorf("fallback: got %q", got)
+	}
+}
@@ -0,0 +1,119 @@
+package fmt
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// messageEntriesMu/messageEntries hold RegisterMessages' registry: one
+// LocStr of full-sentence templates per message id, separate from
+// RegisterWords' per-word dictionary since a template owns its own word
+// order.
+var (
+	messageEntriesMu sync.RWMutex
+	messageEntries   = map[string]LocStr{}
+)
+
+// RegisterMessages registers (or overwrites) id's per-language templates.
+// Unlike a RegisterWords DictEntry, each LocStr slot here is a full
+// sentence with "{0}", "{1}", ... positional placeholders, so languages
+// with a word order Translate's word-by-word composition can't reproduce
+// (Hindi SOV, German verb-final, Arabic VSO) place the substitution
+// wherever their grammar requires instead of slotting a word into an
+// English-ordered sentence:
+//
+//	RegisterMessages("not_found", LocStr{
+//		EN: "{0} not found",
+//		ES: "no se encontró {0}",
+//		HI: "{0} नहीं मिला",
+//		AR: "لم يتم العثور على {0}",
+//	})
+func RegisterMessages(id string, forms LocStr) {
+	messageEntriesMu.Lock()
+	defer messageEntriesMu.Unlock()
+	messageEntries[id] = forms
+}
+
+// messageTemplateFor returns id's template for l, falling back to EN's the
+// same way lookupPluralCategory falls back to EN's branch.
+func messageTemplateFor(id string, l lang) (string, bool) {
+	messageEntriesMu.RLock()
+	forms, ok := messageEntries[id]
+	messageEntriesMu.RUnlock()
+	if !ok {
+		return "", false
+	}
+	if text := forms[l]; text != "" {
+		return text, true
+	}
+	return forms[EN], forms[EN] != ""
+}
+
+// Msg resolves id's template for the current output language (see
+// OutLang), falling back to EN, and substitutes args into its "{0}",
+// "{1}", ... placeholders -- each of which may carry a case modifier,
+// "{0:upper}", "{0:lower}" or "{0:cap}", applied to the arg via
+// ToUpper/ToLower/Capitalize before substitution. This is additive to
+// Translate/Err's word-level lookup: Msg is for a full sentence whose
+// shape varies by language, Translate for composing a sentence from
+// independently-translated words. Returns id itself, unsubstituted, if no
+// template -- not even EN's -- is registered.
+func Msg(id string, args ...any) *Conv {
+	text, ok := messageTemplateFor(id, getCurrentLang())
+	if !ok {
+		return Convert(id)
+	}
+	return Convert(renderMsgTemplate(text, args))
+}
+
+// renderMsgTemplate walks text once, copying literal runs verbatim and
+// substituting each "{N}" or "{N:modifier}" placeholder as it's found. A
+// small state machine rather than TranslatePlural's render's repeated
+// strings.ReplaceAll, since a modifier needs the placeholder's inner text
+// parsed, not just located. An unresolvable placeholder (bad index,
+// missing arg) is left in the output verbatim.
+func renderMsgTemplate(text string, args []any) string {
+	var out strings.Builder
+	i := 0
+	for i < len(text) {
+		if text[i] != '{' {
+			out.WriteByte(text[i])
+			i++
+			continue
+		}
+		end := strings.IndexByte(text[i:], '}')
+		if end == -1 {
+			out.WriteString(text[i:])
+			break
+		}
+		token := text[i+1 : i+end]
+		idxStr, modifier, _ := strings.Cut(token, ":")
+		idx, err := strconv.Atoi(idxStr)
+		if err != nil || idx < 0 || idx >= len(args) {
+			out.WriteString(text[i : i+end+1])
+			i += end + 1
+			continue
+		}
+		out.WriteString(applyMsgModifier(args[idx], modifier))
+		i += end + 1
+	}
+	return out.String()
+}
+
+// applyMsgModifier formats arg the way Sprintf's "%v" would, then applies
+// a placeholder modifier ("upper", "lower", "cap") via the same
+// case-conversion chain Msg's doc comment promises.
+func applyMsgModifier(arg any, modifier string) string {
+	s := Sprintf("%v", arg)
+	switch modifier {
+	case "upper":
+		return Convert(s).ToUpper().String()
+	case "lower":
+		return Convert(s).ToLower().String()
+	case "cap":
+		return Convert(s).Capitalize().String()
+	default:
+		return s
+	}
+}
@@ -0,0 +1,66 @@
+package fmt
+
+import "testing"
+
+func TestMsgWordOrderPerLanguage(t *testing.T) {
+	RegisterMessages("not_found", LocStr{
+		EN: "{0} not found",
+		ES: "no se encontró {0}",
+		HI: "{0} नहीं मिला",
+		AR: "لم يتم العثور على {0}",
+	})
+
+	tests := []struct {
+		name string
+		lang string
+		want string
+	}{
+		{"english", "en", "archivo not found"},
+		{"spanish keeps its own word order", "es", "no se encontró archivo"},
+		{"hindi", "hi", "archivo नहीं मिला"},
+		{"arabic", "ar", "لم يتم العثور على archivo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			OutLang(tt.lang)
+			defer OutLang(EN)
+
+			got := Msg("not_found", "archivo").String()
+			if got != tt.want {
+				t.Errorf("Msg(not_found, archivo) in %s: got %q want %q", tt.lang, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgFallsBackToEnglishTemplate(t *testing.T) {
+	RegisterMessages("greeting", LocStr{EN: "hello, {0}"})
+
+	OutLang("de")
+	defer OutLang(EN)
+
+	got := Msg("greeting", "Ana").String()
+	want := "hello, Ana"
+	if got != want {
+		t.Errorf("Msg with no DE template: got %q want %q", got, want)
+	}
+}
+
+func TestMsgCaseModifiers(t *testing.T) {
+	RegisterMessages("welcome", LocStr{EN: "welcome, {0:upper}! ({1:cap})"})
+
+	got := Msg("welcome", "ana", "returning user").String()
+	want := "welcome, ANA! (Returning User)"
+	if got != want {
+		t.Errorf("Msg with modifiers: got %q want %q", got, want)
+	}
+}
+
+func TestMsgUnregisteredIDReturnsIDItself(t *testing.T) {
+	got := Msg("no_such_message", "x").String()
+	want := "no_such_message"
+	if got != want {
+		t.Errorf("Msg(unregistered): got %q want %q", got, want)
+	}
+}
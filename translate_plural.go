@@ -0,0 +1,218 @@
+package fmt
+
+import (
+	"strings"
+	"sync"
+)
+
+// PluralCategory is one of the CLDR plural categories used to pick the
+// right wording for a count-dependent message.
+type PluralCategory string
+
+// CLDR plural categories, ordered the way most catalogs enumerate forms:
+// zero, one, two, few, many, other. Not every language uses every category;
+// unused ones simply never get returned by a language's rule func.
+const (
+	PluralZero  PluralCategory = "zero"
+	PluralOne   PluralCategory = "one"
+	PluralTwo   PluralCategory = "two"
+	PluralFew   PluralCategory = "few"
+	PluralMany  PluralCategory = "many"
+	PluralOther PluralCategory = "other"
+)
+
+// pluralCategoryOrder is the canonical CLDR ordering used to line up a
+// language's rule output with the positional forms passed to Plural.
+var pluralCategoryOrder = []PluralCategory{
+	PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany, PluralOther,
+}
+
+// pluralForms maps a form count to the subset (and order) of categories it
+// is assumed to represent. Most registered languages only ever need "one"
+// and "other", or the Slavic "one/few/other" triad.
+var pluralForms = map[int][]PluralCategory{
+	1: {PluralOther},
+	2: {PluralOne, PluralOther},
+	3: {PluralOne, PluralFew, PluralOther},
+	4: {PluralOne, PluralFew, PluralMany, PluralOther},
+	6: pluralCategoryOrder,
+}
+
+// PluralRuleFunc maps a count to the CLDR category it resolves to for a
+// given language.
+type PluralRuleFunc func(n int) PluralCategory
+
+var (
+	pluralRulesMu sync.RWMutex
+	pluralRules   = map[lang]PluralRuleFunc{}
+)
+
+// englishPluralRule is the fallback rule used for any language that has not
+// registered its own: "one" iff n == 1, "other" otherwise.
+func englishPluralRule(n int) PluralCategory {
+	if n == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// RegisterLanguage registers a plural rule for the given language code
+// (accepted in any form OutLang accepts, e.g. "ru", "RU", "ru-RU").
+// Languages without a registered rule fall back to englishPluralRule.
+func RegisterLanguage(code string, rule PluralRuleFunc) {
+	c := GetConv()
+	l := c.langParser(code)
+	c.putConv()
+
+	pluralRulesMu.Lock()
+	pluralRules[l] = rule
+	pluralRulesMu.Unlock()
+}
+
+// resolvePluralCategory returns the CLDR category n resolves to for l,
+// falling back to English's one/other split when l has no registered rule.
+func resolvePluralCategory(l lang, n int) PluralCategory {
+	pluralRulesMu.RLock()
+	rule, ok := pluralRules[l]
+	pluralRulesMu.RUnlock()
+	if !ok {
+		rule = englishPluralRule
+	}
+	return rule(n)
+}
+
+// Plural appends the form of forms that matches n's CLDR plural category
+// for the current output language (see OutLang), and returns c for further
+// chaining (e.g. .Capitalize().String()).
+//
+// forms are positional: 2 forms are read as {one, other}, 3 as
+// {one, few, other}, 4 as {one, few, many, other}, and 6 as the full
+// {zero, one, two, few, many, other}. A single form is used verbatim.
+// If the resolved category has no matching form, the last form (other) is
+// used instead.
+func (c *Conv) Plural(n int, forms ...string) *Conv {
+	if len(c.err) > 0 || len(forms) == 0 {
+		return c
+	}
+
+	category := resolvePluralCategory(getCurrentLang(), n)
+	return c.Write(pickPluralForm(category, forms))
+}
+
+// pickPluralForm returns the form in forms matching category, using the
+// same positional convention Plural documents (2 forms read as
+// {one, other}, 3 as {one, few, other}, 4 as {one, few, many, other}, 6 as
+// the full CLDR set), falling back to the last form (other) when category
+// has no corresponding entry.
+func pickPluralForm(category PluralCategory, forms []string) string {
+	if len(forms) == 0 {
+		return ""
+	}
+
+	order, ok := pluralForms[len(forms)]
+	if !ok {
+		order = pluralCategoryOrder[len(pluralCategoryOrder)-len(forms):]
+	}
+
+	selected := forms[len(forms)-1]
+	for i, cat := range order {
+		if cat == category && i < len(forms) {
+			selected = forms[i]
+			break
+		}
+	}
+	return selected
+}
+
+// Select appends the case whose key matches key, falling back to the case
+// registered under "other" (or "" if none was provided). cases is a flat
+// list of alternating key/value pairs, e.g.:
+//
+//	Translate("...").Select("male", "male", "Mr.", "female", "Ms.", "other", "Mx.")
+func (c *Conv) Select(key string, cases ...string) *Conv {
+	if len(c.err) > 0 {
+		return c
+	}
+
+	selected, fallback := "", ""
+	for i := 0; i+1 < len(cases); i += 2 {
+		if cases[i] == key {
+			selected = cases[i+1]
+			break
+		}
+		if cases[i] == "other" {
+			fallback = cases[i+1]
+		}
+	}
+	if selected == "" {
+		selected = fallback
+	}
+
+	return c.Write(selected)
+}
+
+var (
+	pluralWordsMu sync.RWMutex
+	pluralWords   = map[string]map[lang]string{}
+)
+
+// RegisterPluralWords registers the colon-separated plural payload (the
+// same "one:other" / "zero:one:two:few:many:other" grammar RenderPluralVerb's
+// payload accepts) a bare key resolves to per language, mirroring how
+// RegisterFormats keys FormatEntry by language without RegisterWords'
+// DictEntry growing a field for every feature. key is matched exactly
+// (case-sensitively).
+func RegisterPluralWords(key string, forms map[string]string) {
+	c := GetConv()
+	defer c.putConv()
+
+	resolved := make(map[lang]string, len(forms))
+	for code, payload := range forms {
+		resolved[c.langParser(code)] = payload
+	}
+
+	pluralWordsMu.Lock()
+	defer pluralWordsMu.Unlock()
+	existing, ok := pluralWords[key]
+	if !ok {
+		existing = map[lang]string{}
+		pluralWords[key] = existing
+	}
+	for l, payload := range resolved {
+		existing[l] = payload
+	}
+}
+
+// pluralWordsFor returns the colon-separated plural payload key was
+// registered under for l via RegisterPluralWords, and whether one exists.
+func pluralWordsFor(key string, l lang) (string, bool) {
+	pluralWordsMu.RLock()
+	defer pluralWordsMu.RUnlock()
+	byLang, ok := pluralWords[key]
+	if !ok {
+		return "", false
+	}
+	payload, ok := byLang[l]
+	return payload, ok
+}
+
+// RenderPluralVerb returns the form in payload matching n's CLDR plural
+// category for the current output language (see OutLang). payload is
+// either a literal colon-separated form list ("file:files",
+// "zero:one:two:few:many:other") or a bare key registered via
+// RegisterPluralWords for the current output language; a bare key with no
+// registration for that language is returned unchanged. The category itself
+// is resolved the same way Plural resolves it, via the current language's
+// rule (see RegisterLanguage). No format-string verb (such as %P in
+// Html/Translate) calls it; callers invoke it directly.
+func RenderPluralVerb(payload string, n int) string {
+	l := getCurrentLang()
+	if !strings.Contains(payload, ":") {
+		if resolved, ok := pluralWordsFor(payload, l); ok {
+			payload = resolved
+		}
+	}
+
+	category := resolvePluralCategory(l, n)
+	return pickPluralForm(category, strings.Split(payload, ":"))
+}
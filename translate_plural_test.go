@@ -0,0 +1,60 @@
+package fmt
+
+import "testing"
+
+func TestTranslatePlural(t *testing.T) {
+	RegisterWords([]DictEntry{
+		{EN: "You have"},
+	})
+	RegisterLanguage("ru", func(n int) PluralCategory {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return PluralOne
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return PluralFew
+		default:
+			return PluralMany
+		}
+	})
+
+	tests := []struct {
+		name  string
+		lang  string
+		n     int
+		forms []string
+		want  string
+	}{
+		{"english one", "en", 1, []string{"item", "items"}, "item"},
+		{"english other", "en", 3, []string{"item", "items"}, "items"},
+		{"russian one", "ru", 1, []string{"файл", "файла", "файлов"}, "файл"},
+		{"russian few", "ru", 3, []string{"файл", "файла", "файлов"}, "файла"},
+		{"russian many", "ru", 5, []string{"файл", "файла", "файлов"}, "файлов"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			OutLang(tt.lang)
+			got := Translate("you have").Plural(tt.n, tt.forms...).String()
+			want := Translate("you have").String() + tt.want
+			if got != want {
+				t.Errorf("Plural(%d, %v): got %q want %q", tt.n, tt.forms, got, want)
+			}
+		})
+	}
+}
+
+func TestTranslateSelect(t *testing.T) {
+	OutLang(EN)
+
+	got := Translate("Welcome").Select("female", "male", "Mr.", "female", "Ms.", "other", "Mx.").String()
+	want := Translate("Welcome").String() + "Ms."
+	if got != want {
+		t.Errorf("Select(female): got %q want %q", got, want)
+	}
+
+	got = Translate("Welcome").Select("unknown", "male", "Mr.", "female", "Ms.", "other", "Mx.").String()
+	want = Translate("Welcome").String() + "Mx."
+	if got != want {
+		t.Errorf("Select(unknown) fallback: got %q want %q", got, want)
+	}
+}
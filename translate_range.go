@@ -0,0 +1,90 @@
+package fmt
+
+import "sync"
+
+// RangeCase describes one interval of a Range selection. Low/High bound the
+// interval (inclusive/exclusive controlled by LowInclusive/HighInclusive);
+// Text is returned when value falls inside it.
+type RangeCase struct {
+	Low, High                   float64
+	LowInclusive, HighInclusive bool
+	Text                        string
+	// isDefaultCase marks the catch-all entry RangeDefault produces. It is
+	// a dedicated field rather than inferred from zero-valued bounds
+	// because Low==0, High==0, LowInclusive==true, HighInclusive==true is
+	// itself a legitimate interval a caller can write by hand (e.g. "0
+	// items" matching exactly 0) and must not be mistaken for the default.
+	isDefaultCase bool
+}
+
+// RangeDefault wraps the text returned when value matches none of the
+// supplied RangeCase intervals.
+func RangeDefault(text string) RangeCase {
+	return RangeCase{Text: text, isDefaultCase: true}
+}
+
+func (rc RangeCase) isDefault() bool {
+	return rc.isDefaultCase
+}
+
+func (rc RangeCase) matches(value float64) bool {
+	if rc.isDefault() {
+		return false
+	}
+	lowOK := value > rc.Low || (rc.LowInclusive && value == rc.Low)
+	highOK := value < rc.High || (rc.HighInclusive && value == rc.High)
+	return lowOK && highOK
+}
+
+// resolveRange scans intervals in order and returns the first match's Text,
+// falling back to the Text of a RangeDefault entry (or "" if none given).
+func resolveRange(value float64, intervals []RangeCase) string {
+	fallback := ""
+	for _, rc := range intervals {
+		if rc.isDefault() {
+			fallback = rc.Text
+			continue
+		}
+		if rc.matches(value) {
+			return rc.Text
+		}
+	}
+	return fallback
+}
+
+// Range appends the text of the first RangeCase whose interval contains
+// value (declaration order), or the RangeDefault text if none match, and
+// returns c for further chaining.
+func (c *Conv) Range(value float64, intervals ...RangeCase) *Conv {
+	if len(c.err) > 0 {
+		return c
+	}
+	return c.Write(resolveRange(value, intervals))
+}
+
+var (
+	rangeSetsMu sync.RWMutex
+	rangeSets   = map[string][]RangeCase{}
+)
+
+// RegisterRangeSet names a reusable slice of RangeCase so RangeNamed can
+// resolve it across multiple Translate calls without re-declaring the
+// intervals each time.
+func RegisterRangeSet(name string, intervals ...RangeCase) {
+	rangeSetsMu.Lock()
+	rangeSets[name] = intervals
+	rangeSetsMu.Unlock()
+}
+
+// RangeNamed behaves like Range but looks up its intervals from a set
+// previously registered with RegisterRangeSet. An unknown name resolves to
+// "".
+func (c *Conv) RangeNamed(value float64, name string) *Conv {
+	if len(c.err) > 0 {
+		return c
+	}
+	rangeSetsMu.RLock()
+	intervals := rangeSets[name]
+	rangeSetsMu.RUnlock()
+	return c.Write(resolveRange(value, intervals))
+}
@@ -0,0 +1,45 @@
+package fmt
+
+import "testing"
+
+func TestTranslateRange(t *testing.T) {
+	intervals := []RangeCase{
+		{Low: 0, High: 0, LowInclusive: true, HighInclusive: true, Text: "0 items"},
+		{Low: 1, High: 9, LowInclusive: true, HighInclusive: true, Text: "1-9 items"},
+		RangeDefault("10+ items"),
+	}
+
+	tests := []struct {
+		value float64
+		want  string
+	}{
+		{0, "0 items"},
+		{1, "1-9 items"},
+		{9, "1-9 items"},
+		{10, "10+ items"},
+		{1000, "10+ items"},
+	}
+
+	for _, tt := range tests {
+		got := Translate().Range(tt.value, intervals...).String()
+		if got != tt.want {
+			t.Errorf("Range(%v): got %q want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestTranslateRangeNamed(t *testing.T) {
+	RegisterRangeSet("itemCount",
+		RangeCase{Low: 0, High: 0, LowInclusive: true, HighInclusive: true, Text: "none"},
+		RangeDefault("some"),
+	)
+
+	got := Translate().RangeNamed(0, "itemCount").String()
+	if got != "none" {
+		t.Errorf("RangeNamed(0): got %q want %q", got, "none")
+	}
+	got = Translate().RangeNamed(5, "itemCount").String()
+	if got != "some" {
+		t.Errorf("RangeNamed(5): got %q want %q", got, "some")
+	}
+}